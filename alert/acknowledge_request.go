@@ -4,28 +4,24 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type AcknowledgeAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
 	Note            string `json:"note,omitempty"`
 }
 
 func (r *AcknowledgeAlertRequest) Validate() error {
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AcknowledgeAlertRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/acknowledge"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/acknowledge"
 
 }
 
@@ -49,3 +45,7 @@ func (r *AcknowledgeAlertRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AcknowledgeAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -4,33 +4,33 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type AddDetailsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Details         map[string]string `json:"details,omitempty"`
+	IdentifierValue string            `validate:"required"`
+	Details         map[string]string `json:"details,omitempty" validate:"required"`
 	User            string            `json:"user,omitempty"`
 	Source          string            `json:"source,omitempty"`
 	Note            string            `json:"note,omitempty"`
 }
 
 func (r *AddDetailsRequest) Validate() error {
-	if len(r.Details) == 0 {
-		return errors.New("Details can not be empty")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	for key := range r.Details {
+		if err := validateDetailKey(key); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (r *AddDetailsRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/details"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/details"
 
 }
 
@@ -54,3 +54,7 @@ func (r *AddDetailsRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AddDetailsRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
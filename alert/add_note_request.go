@@ -4,31 +4,24 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type AddNoteRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
-	Note            string `json:"note,omitempty"`
+	Note            string `json:"note,omitempty" validate:"required"`
 }
 
 func (r *AddNoteRequest) Validate() error {
-	if r.Note == "" {
-		return errors.New("Note can not be empty")
-	}
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddNoteRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/notes"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/notes"
 
 }
 
@@ -52,3 +45,7 @@ func (r *AddNoteRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AddNoteRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -2,6 +2,7 @@ package alert
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -10,34 +11,33 @@ import (
 type AddResponderRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Responder       Responder `json:"responder,omitempty"`
+	IdentifierValue string    `validate:"required"`
+	Responder       Responder `json:"responder,omitempty" validate:"nested"`
 	User            string    `json:"user,omitempty"`
 	Source          string    `json:"source,omitempty"`
 	Note            string    `json:"note,omitempty"`
 }
 
 func (r *AddResponderRequest) Validate() error {
-
-	if r.Responder.Type != UserResponder && r.Responder.Type != TeamResponder {
-		return errors.New("Responder type must be user or team")
-	}
-	if r.Responder.Type == UserResponder && r.Responder.Id == "" && r.Responder.Username == "" {
-		return errors.New("User ID or username must be defined")
+	switch r.Responder.Type {
+	case UserResponder:
+		if r.Responder.Id == "" && r.Responder.Username == "" {
+			return errors.New("User ID or username must be defined")
+		}
+	case TeamResponder, EscalationResponder, ScheduleResponder:
+		if r.Responder.Id == "" && r.Responder.Name == "" {
+			return errors.Errorf("%s%s ID or name must be defined", strings.ToUpper(string(r.Responder.Type[:1])), r.Responder.Type[1:])
+		}
+		if r.Responder.Username != "" {
+			return errors.Errorf("Username can not be defined for %s responders", r.Responder.Type)
+		}
 	}
-	if r.Responder.Type == TeamResponder && r.Responder.Id == "" && r.Responder.Name == "" {
-		return errors.New("Team ID or name must be defined")
-	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddResponderRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/responders"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/responders"
 
 }
 
@@ -61,3 +61,7 @@ func (r *AddResponderRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AddResponderRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -4,33 +4,25 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type AddTagsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Tags            []string `json:"tags,omitempty"`
+	IdentifierValue string   `validate:"required"`
+	Tags            []string `json:"tags,omitempty" validate:"required"`
 	User            string   `json:"user,omitempty"`
 	Source          string   `json:"source,omitempty"`
 	Note            string   `json:"note,omitempty"`
 }
 
 func (r *AddTagsRequest) Validate() error {
-	if len(r.Tags) == 0 {
-		return errors.New("Tags list can not be empty")
-	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddTagsRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/tags"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/tags"
 
 }
 
@@ -54,3 +46,7 @@ func (r *AddTagsRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AddTagsRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -10,7 +10,7 @@ import (
 type AddTeamRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Team            Team   `json:"team,omitempty"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
@@ -18,19 +18,21 @@ type AddTeamRequest struct {
 }
 
 func (r *AddTeamRequest) Validate() error {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
 	if r.Team.ID == "" && r.Team.Name == "" {
 		return errors.New("Team ID or name must be defined")
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	if r.Team.ID != "" && r.Team.Name != "" {
+		return errors.New("Only one of team ID or name can be defined")
 	}
 	return nil
 }
 
 func (r *AddTeamRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/teams"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/teams"
 
 }
 
@@ -54,3 +56,7 @@ func (r *AddTeamRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AddTeamRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -20,6 +20,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	return &Client{client: opsgenieClient}, nil
 }
 
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
+
 func (c *Client) Create(ctx context.Context, req *CreateAlertRequest) (*AsyncAlertResult, error) {
 
 	result := &AsyncAlertResult{}
@@ -35,6 +41,28 @@ func (c *Client) Create(ctx context.Context, req *CreateAlertRequest) (*AsyncAle
 
 }
 
+// CompileCreate compiles the request for CreateFromTemplate. String fields, such as Message and Alias, may contain
+// client.Placeholders that are replaced on every call.
+func (c *Client) CompileCreate(req *CreateAlertRequest) (*client.RequestTemplate, error) {
+	return c.client.CompileTemplate(req)
+}
+
+// CreateFromTemplate creates an alert from a template compiled with CompileCreate.
+func (c *Client) CreateFromTemplate(ctx context.Context, template *client.RequestTemplate, values map[string]string) (*AsyncAlertResult, error) {
+
+	result := &AsyncAlertResult{}
+
+	err := c.client.ExecTemplate(ctx, template, values, result)
+	if err != nil {
+		return nil, err
+	}
+
+	result.asyncBaseResult = &client.AsyncBaseResult{Client: c.client}
+
+	return result, nil
+
+}
+
 func (c *Client) Delete(ctx context.Context, req *DeleteAlertRequest) (*AsyncAlertResult, error) {
 
 	result := &AsyncAlertResult{}
@@ -502,3 +530,9 @@ func (ar *AsyncAlertResult) RetrieveStatus(ctx context.Context) (*RequestStatusR
 
 	return result, nil
 }
+
+// WaitForCompletion blocks until the request is processed and returns the id of the affected alert.
+func (ar *AsyncAlertResult) WaitForCompletion(ctx context.Context, options client.WaitOptions) (string, error) {
+	req := &GetRequestStatusRequest{RequestId: ar.RequestId}
+	return ar.asyncBaseResult.WaitForCompletion(ctx, req, &RequestStatusResult{}, options)
+}
@@ -1,6 +1,12 @@
 package alert
 
-import "github.com/pkg/errors"
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
 
 type SortField string
 
@@ -34,6 +40,25 @@ type Report struct {
 	ClosedBy       string `json:"closedBy,omitempty"`
 }
 
+// AckDuration returns the time between the creation and the acknowledgement of the alert, zero if it is not
+// acknowledged yet.
+func (r Report) AckDuration() time.Duration {
+	return time.Duration(r.AckTime) * time.Millisecond
+}
+
+// CloseDuration returns the time between the creation and the closing of the alert, zero if it is not closed yet.
+func (r Report) CloseDuration() time.Duration {
+	return time.Duration(r.CloseTime) * time.Millisecond
+}
+
+func (r Report) IsAcknowledged() bool {
+	return r.AckTime > 0 || r.AcknowledgedBy != ""
+}
+
+func (r Report) IsClosed() bool {
+	return r.CloseTime > 0 || r.ClosedBy != ""
+}
+
 type Order string
 
 const (
@@ -66,9 +91,18 @@ const (
 	TINYID
 )
 
-func validateIdentifier(identifier string) error {
-	if identifier == "" {
-		return errors.New("Identifier can not be empty")
+// validateDetailKey rejects keys that cannot be removed again: keys are removed by a comma separated parameter.
+func validateDetailKey(key string) error {
+	if key == "" {
+		return errors.New("Detail key can not be empty")
+	}
+	if strings.Contains(key, ",") {
+		return errors.Errorf("Detail key %s can not contain a comma", key)
+	}
+	for _, r := range key {
+		if unicode.IsControl(r) {
+			return errors.Errorf("Detail key %q can not contain control characters", key)
+		}
 	}
 	return nil
 }
@@ -79,3 +113,19 @@ const (
 	NEXT RequestDirection = "next"
 	PREV RequestDirection = "prev"
 )
+
+type ExpandType string
+
+const (
+	ExpandDetails    ExpandType = "details"
+	ExpandResponders ExpandType = "responders"
+	ExpandReport     ExpandType = "report"
+)
+
+func joinExpands(expands []ExpandType) string {
+	values := make([]string, len(expands))
+	for i, expand := range expands {
+		values[i] = string(expand)
+	}
+	return strings.Join(values, ",")
+}
@@ -1,8 +1,11 @@
 package alert
 
 import (
+	"encoding/json"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 	"time"
 )
@@ -13,7 +16,7 @@ func TestCreateRequest_Validate(t *testing.T) {
 	}
 	err := createRequestWithoutMessage.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("message can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("message cannot be empty.").Error())
 
 	createRequest := &CreateAlertRequest{
 		Message: "message",
@@ -25,11 +28,26 @@ func TestCreateRequest_Validate(t *testing.T) {
 
 }
 
+func TestCreateRequest_ValidateTags(t *testing.T) {
+	createRequest := &CreateAlertRequest{
+		Message:    "message",
+		Priority:   "P9",
+		Responders: []Responder{{Type: UserResponder, Username: "john@example.com"}, {Name: "ops"}},
+	}
+	err := client.ValidateStruct(createRequest)
+
+	assert.Equal(t, "responders[1].type cannot be empty.; priority should be one of P1, P2, P3, P4, P5.", err.Error())
+
+	// Strings longer than the limits of the API are truncated by it, collections are not.
+	createRequest = &CreateAlertRequest{Message: strings.Repeat("m", 200), Tags: make([]string, 30)}
+	assert.Equal(t, "tags cannot be longer than 20 items.", client.ValidateStruct(createRequest).Error())
+}
+
 func TestAcknowledgeAlertRequest_Validate(t *testing.T) {
 	acknowledgeAlertRequestWithError := &AcknowledgeAlertRequest{}
 	err := acknowledgeAlertRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	acknowledgeAlertRequest := &AcknowledgeAlertRequest{
 		IdentifierType:  ALIAS,
@@ -49,7 +67,7 @@ func TestAddNoteRequest_Validate(t *testing.T) {
 	}
 	err := addNoteRequest.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Note can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("note cannot be empty.").Error())
 
 	addNoteRequestWithoutidentifier := &AddNoteRequest{
 		IdentifierType: TINYID,
@@ -57,7 +75,7 @@ func TestAddNoteRequest_Validate(t *testing.T) {
 	}
 	err = addNoteRequestWithoutidentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	noteRequest := &AddNoteRequest{
 		IdentifierType:  ALIAS,
@@ -73,7 +91,7 @@ func TestCloseAlertRequest_Validate(t *testing.T) {
 	closeAlertRequestWithError := &CloseAlertRequest{}
 	err := closeAlertRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	closeAlertRequest := &CloseAlertRequest{
 		IdentifierType:  ALIAS,
@@ -97,7 +115,7 @@ func TestCreateSavedSearchRequest_Validate(t *testing.T) {
 	}
 	err := createSavedSearchRequestWithoutName.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Name can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("name cannot be empty.").Error())
 
 	createSavedSearchRequestWithoutQuery := &CreateSavedSearchRequest{
 		Name:  "name1",
@@ -111,7 +129,7 @@ func TestCreateSavedSearchRequest_Validate(t *testing.T) {
 	}
 	err = createSavedSearchRequestWithoutQuery.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Query can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("query cannot be empty.").Error())
 
 	createSavedSearchRequestWithoutOwner := &CreateSavedSearchRequest{
 		Name:        "name1",
@@ -142,7 +160,7 @@ func TestDeleteAlertRequest_Validate(t *testing.T) {
 	deleteAlertRequestWithError := &DeleteAlertRequest{}
 	err := deleteAlertRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	deleteAlertRequest := &DeleteAlertRequest{
 		IdentifierType:  TINYID,
@@ -157,7 +175,7 @@ func TestDeleteSavedSearchRequest_Validate(t *testing.T) {
 	deleteSavedSearchRequestWithError := &DeleteSavedSearchRequest{}
 	err := deleteSavedSearchRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	deleteSavedSearchRequest := &DeleteSavedSearchRequest{
 		IdentifierType:  NAME,
@@ -187,7 +205,7 @@ func TestEscalateToNextRequest_Validate(t *testing.T) {
 	}
 	err = escalateToNextRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	escalateToNextRequest := &EscalateToNextRequest{
 		IdentifierType:  ALERTID,
@@ -209,7 +227,7 @@ func TestExecuteCustomActionAlertRequest_Validate(t *testing.T) {
 	}
 	err := executeCustomActionAlertRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	executeCustomActionAlertRequestWithoutAction := &ExecuteCustomActionAlertRequest{
 		IdentifierType:  ALIAS,
@@ -217,7 +235,7 @@ func TestExecuteCustomActionAlertRequest_Validate(t *testing.T) {
 	}
 	err = executeCustomActionAlertRequestWithoutAction.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Action can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("Action cannot be empty.").Error())
 
 	customActionAlertRequest := &ExecuteCustomActionAlertRequest{
 		IdentifierType:  ALERTID,
@@ -233,7 +251,7 @@ func TestGetAlertRequest_Validate(t *testing.T) {
 	getAlertRequestWithError := &GetAlertRequest{}
 	err := getAlertRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	getAlertRequest := &GetAlertRequest{
 		IdentifierType:  ALERTID,
@@ -244,11 +262,48 @@ func TestGetAlertRequest_Validate(t *testing.T) {
 	assert.Equal(t, err, nil)
 }
 
+func TestAlertRequests_FieldsAndExpands(t *testing.T) {
+	getAlertRequest := &GetAlertRequest{IdentifierValue: "id1"}
+	_, ok := getAlertRequest.RequestParams()["fields"]
+	assert.False(t, ok)
+
+	getAlertRequest.Fields = []string{"id", "message"}
+	getAlertRequest.Expands = []ExpandType{ExpandDetails, ExpandResponders}
+	params := getAlertRequest.RequestParams()
+	assert.Equal(t, "id,message", params["fields"])
+	assert.Equal(t, "details,responders", params["expand"])
+
+	listAlertRequest := &ListAlertRequest{Fields: []string{"id", "tinyId"}, Expands: []ExpandType{ExpandReport}}
+	params = listAlertRequest.RequestParams()
+	assert.Equal(t, "id,tinyId", params["fields"])
+	assert.Equal(t, "report", params["expand"])
+}
+
+func TestListAlertLogsAndNotesRequest_ListParams(t *testing.T) {
+	logsRequest := &ListAlertLogsRequest{IdentifierValue: "id1", Offset: 20, Order: Desc, Limit: 10}
+	assert.Nil(t, logsRequest.Validate())
+	params := logsRequest.RequestParams()
+	assert.Equal(t, "20", params["offset"])
+	assert.Equal(t, "desc", params["order"])
+	assert.Equal(t, "10", params["limit"])
+
+	logsRequest.Order = "newest"
+	assert.EqualError(t, logsRequest.Validate(), "Order should be one of asc or desc.")
+
+	notesRequest := &ListAlertNotesRequest{IdentifierValue: "id1", Offset: "cursor", Order: Asc}
+	assert.Nil(t, notesRequest.Validate())
+	params = notesRequest.RequestParams()
+	assert.Equal(t, "cursor", params["offset"])
+	assert.Equal(t, "asc", params["order"])
+	_, ok := params["limit"]
+	assert.False(t, ok)
+}
+
 func TestGetAsyncRequestStatusRequest_Validate(t *testing.T) {
 	getAsyncRequestStatusRequestWithError := &GetRequestStatusRequest{}
 	err := getAsyncRequestStatusRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("RequestId can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("requestId cannot be empty.").Error())
 
 	asyncRequestStatusRequest := &GetRequestStatusRequest{
 		RequestId: "reqId",
@@ -262,7 +317,7 @@ func TestSnoozeAlertRequest_Validate(t *testing.T) {
 	snoozeAlertRequestWithoutIdentifier := &SnoozeAlertRequest{}
 	err := snoozeAlertRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	snoozeAlertRequestWithInvalidEndtime := &SnoozeAlertRequest{
 		IdentifierValue: "alias1",
@@ -296,7 +351,7 @@ func TestUnacknowledgeAlertRequest_Validate(t *testing.T) {
 	unacknowledgeAlertRequestWithError := &UnacknowledgeAlertRequest{}
 	err := unacknowledgeAlertRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	unacknowledgeAlertRequest := &UnacknowledgeAlertRequest{
 		IdentifierType:  ALERTID,
@@ -311,7 +366,7 @@ func TestUpdateSavedSearchRequest_Validate(t *testing.T) {
 	updateSavedSearchRequestWithoutIdentifier := &UpdateSavedSearchRequest{}
 	err := updateSavedSearchRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.; name cannot be empty.; query cannot be empty.").Error())
 
 	updateSavedSearchRequestWithoutName := &UpdateSavedSearchRequest{
 		IdentifierValue: "name1",
@@ -327,7 +382,7 @@ func TestUpdateSavedSearchRequest_Validate(t *testing.T) {
 	}
 	err = updateSavedSearchRequestWithoutName.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Name can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("name cannot be empty.").Error())
 
 	updateSavedSearchRequestWithoutQuery := &UpdateSavedSearchRequest{
 		IdentifierValue: "name1",
@@ -343,7 +398,7 @@ func TestUpdateSavedSearchRequest_Validate(t *testing.T) {
 	}
 	err = updateSavedSearchRequestWithoutQuery.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Query can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("query cannot be empty.").Error())
 
 	updateSavedSearchRequestWithoutOwner := &UpdateSavedSearchRequest{
 		IdentifierValue: "name1",
@@ -391,7 +446,7 @@ func TestAssignRequest_Validate(t *testing.T) {
 	}
 	err = assignRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	assignRequest := &AssignRequest{
 		IdentifierValue: "tiny1",
@@ -415,6 +470,15 @@ func TestAddTeamRequest_Validate(t *testing.T) {
 
 	assert.Equal(t, err.Error(), errors.New("Team ID or name must be defined").Error())
 
+	addTeamRequestWithIdAndName := &AddTeamRequest{
+		IdentifierType:  ALERTID,
+		IdentifierValue: "Id",
+		Team:            Team{ID: "team1", Name: "payments"},
+	}
+	err = addTeamRequestWithIdAndName.Validate()
+
+	assert.Equal(t, "Only one of team ID or name can be defined", err.Error())
+
 	addTeamRequestWithoutIdentifier := &AddTeamRequest{
 		IdentifierType: ALERTID,
 		Team: Team{
@@ -423,7 +487,7 @@ func TestAddTeamRequest_Validate(t *testing.T) {
 	}
 	err = addTeamRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	addTeamRequest := &AddTeamRequest{
 		IdentifierValue: "tiny1",
@@ -445,7 +509,7 @@ func TestAddTagsRequest_Validate(t *testing.T) {
 	}
 	err := addTagsRequestWithoutTags.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Tags list can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("tags cannot be empty.").Error())
 
 	addTagsRequestWithoutIdentifier := &AddTagsRequest{
 		IdentifierType: ALERTID,
@@ -455,7 +519,7 @@ func TestAddTagsRequest_Validate(t *testing.T) {
 	}
 	err = addTagsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	addTagsRequest := &AddTagsRequest{
 		IdentifierType:  ALERTID,
@@ -485,7 +549,7 @@ func TestRemoveTagsRequest_Validate(t *testing.T) {
 	}
 	err = removeTagsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	removeTagsRequest := &RemoveTagsRequest{
 		IdentifierType:  ALERTID,
@@ -495,6 +559,26 @@ func TestRemoveTagsRequest_Validate(t *testing.T) {
 	err = removeTagsRequest.Validate()
 
 	assert.Equal(t, err, nil)
+
+	removeTagsRequest = &RemoveTagsRequest{
+		IdentifierType:  ALERTID,
+		IdentifierValue: "id1",
+		TagList:         []string{"tag1", "tag,2"},
+	}
+	err = removeTagsRequest.Validate()
+
+	assert.Equal(t, "Tag tag,2 can not contain a comma", err.Error())
+}
+
+func TestRemoveTagsRequest_RequestParams(t *testing.T) {
+	removeTagsRequest := &RemoveTagsRequest{
+		IdentifierValue: "id1",
+		TagList:         []string{"tag1", "tag 2"},
+	}
+	assert.Equal(t, "tag1,tag 2", removeTagsRequest.RequestParams()["tags"])
+
+	removeTagsRequest.Tags = "tag0"
+	assert.Equal(t, "tag0,tag1,tag 2", removeTagsRequest.RequestParams()["tags"])
 }
 
 func TestAddDetailsRequest_Validate(t *testing.T) {
@@ -504,7 +588,7 @@ func TestAddDetailsRequest_Validate(t *testing.T) {
 	}
 	err := addDetailsRequestWithoutKey.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Details can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("details cannot be empty.").Error())
 
 	addDetailsRequestWithoutIdentifier := &AddDetailsRequest{
 		IdentifierType: ALERTID,
@@ -515,7 +599,7 @@ func TestAddDetailsRequest_Validate(t *testing.T) {
 	}
 	err = addDetailsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	addDetailsRequest := &AddDetailsRequest{
 		IdentifierType:  ALERTID,
@@ -528,6 +612,16 @@ func TestAddDetailsRequest_Validate(t *testing.T) {
 	err = addDetailsRequest.Validate()
 
 	assert.Equal(t, err, nil)
+
+	addDetailsRequest.Details = map[string]string{"runbook,url": "https://runbooks/checkout"}
+	err = addDetailsRequest.Validate()
+
+	assert.Equal(t, "Detail key runbook,url can not contain a comma", err.Error())
+
+	addDetailsRequest.Details = map[string]string{"deploy\nsha": "4f2a9c1"}
+	err = addDetailsRequest.Validate()
+
+	assert.Equal(t, `Detail key "deploy\nsha" can not contain control characters`, err.Error())
 }
 
 func TestRemoveDetailsRequest_Validate(t *testing.T) {
@@ -546,7 +640,7 @@ func TestRemoveDetailsRequest_Validate(t *testing.T) {
 	}
 	err = removeDetailsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	removeDetailsRequest := &RemoveDetailsRequest{
 		IdentifierType:  ALERTID,
@@ -556,6 +650,21 @@ func TestRemoveDetailsRequest_Validate(t *testing.T) {
 	err = removeDetailsRequest.Validate()
 
 	assert.Equal(t, err, nil)
+
+	removeDetailsRequest = &RemoveDetailsRequest{
+		IdentifierType:  ALERTID,
+		IdentifierValue: "id1",
+		KeyList:         []string{"runbook-url", ""},
+	}
+	err = removeDetailsRequest.Validate()
+
+	assert.Equal(t, "Detail key can not be empty", err.Error())
+
+	removeDetailsRequest.KeyList = []string{"runbook-url", "deploy-sha"}
+	err = removeDetailsRequest.Validate()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "runbook-url,deploy-sha", removeDetailsRequest.RequestParams()["keys"])
 }
 
 func TestUpdatePriorityRequest_Validate(t *testing.T) {
@@ -566,7 +675,7 @@ func TestUpdatePriorityRequest_Validate(t *testing.T) {
 	}
 	err := updatePriorityRequestWithoutPriority.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Priority can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("priority cannot be empty.").Error())
 
 	updatePriorityRequestWithInvalidPriority := &UpdatePriorityRequest{
 		IdentifierType:  ALERTID,
@@ -575,7 +684,7 @@ func TestUpdatePriorityRequest_Validate(t *testing.T) {
 	}
 	err = updatePriorityRequestWithInvalidPriority.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Priority should be one of these: 'P1', 'P2', 'P3', 'P4' and 'P5'").Error())
+	assert.Equal(t, err.Error(), errors.New("priority should be one of P1, P2, P3, P4, P5.").Error())
 
 	updatePriorityRequestWithoutIdentifier := &UpdatePriorityRequest{
 		IdentifierType: ALERTID,
@@ -583,7 +692,7 @@ func TestUpdatePriorityRequest_Validate(t *testing.T) {
 	}
 	err = updatePriorityRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	updatePriorityRequest := &UpdatePriorityRequest{
 		IdentifierType:  ALERTID,
@@ -603,7 +712,7 @@ func TestUpdateMessageRequest_Validate(t *testing.T) {
 	}
 	err := updateMessageRequestWithoutMessage.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Message can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("message cannot be empty.").Error())
 
 	updateMessageRequestWithoutIdentifier := &UpdateMessageRequest{
 		IdentifierType: ALERTID,
@@ -611,7 +720,7 @@ func TestUpdateMessageRequest_Validate(t *testing.T) {
 	}
 	err = updateMessageRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	updateMessageRequest := &UpdateMessageRequest{
 		IdentifierType:  ALERTID,
@@ -631,7 +740,7 @@ func TestUpdateDescriptionRequest_Validate(t *testing.T) {
 	}
 	err := updateDescriptionRequestWithoutDescription.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Description can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("description cannot be empty.").Error())
 
 	updateDescriptionRequestWithoutIdentifier := &UpdateDescriptionRequest{
 		IdentifierType: ALERTID,
@@ -639,7 +748,7 @@ func TestUpdateDescriptionRequest_Validate(t *testing.T) {
 	}
 	err = updateDescriptionRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	updateDescriptionRequest := &UpdateDescriptionRequest{
 		IdentifierType:  ALERTID,
@@ -655,7 +764,7 @@ func TestListAlertRecipientsRequest_Validate(t *testing.T) {
 	listAlertRecipientsRequestWithError := &ListAlertRecipientRequest{}
 	err := listAlertRecipientsRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	listAlertRecipientRequest := &ListAlertRecipientRequest{
 		IdentifierType:  ALERTID,
@@ -670,7 +779,7 @@ func TestListAlertLogsRequest_Validate(t *testing.T) {
 	listAlertLogsRequestWithError := &ListAlertLogsRequest{}
 	err := listAlertLogsRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	alertLogsRequest := &ListAlertLogsRequest{
 		IdentifierType:  ALERTID,
@@ -685,7 +794,7 @@ func TestListAlertNotesRequest_Validate(t *testing.T) {
 	listAlertNotesRequestWithError := &ListAlertNotesRequest{}
 	err := listAlertNotesRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	listAlertNotesRequest := &ListAlertNotesRequest{
 		IdentifierType:  ALERTID,
@@ -700,7 +809,7 @@ func TestGetSavedSearch_Validate(t *testing.T) {
 	getSavedSearchRequestWithError := &GetSavedSearchRequest{}
 	err := getSavedSearchRequestWithError.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	getSavedSearchRequest := &GetSavedSearchRequest{
 		IdentifierType:  NAME,
@@ -720,7 +829,7 @@ func TestCreateAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err := createAlertAttachmentsRequestWithoutFileName.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("FileName can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("FileName cannot be empty.").Error())
 
 	createAlertAttachmentsRequestWithoutFilePath := &CreateAlertAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -730,7 +839,7 @@ func TestCreateAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err = createAlertAttachmentsRequestWithoutFilePath.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("FilePath can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("FilePath cannot be empty.").Error())
 
 	createAlertAttachmentsRequestWithoutIdentifier := &CreateAlertAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -740,7 +849,7 @@ func TestCreateAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err = createAlertAttachmentsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	createAlertAttachmentRequest := &CreateAlertAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -761,7 +870,7 @@ func TestGetAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err := getAttachmentRequestWithoutFileName.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("AttachmentId can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("AttachmentId cannot be empty.").Error())
 
 	getAttachmentRequestWithoutIdentifier := &GetAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -770,7 +879,7 @@ func TestGetAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err = getAttachmentRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	getAttachmentRequest := &GetAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -789,7 +898,7 @@ func TestListAttachmentsRequest_Validate(t *testing.T) {
 	}
 	err := listAttachmentsRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	listAttachmentsRequest := &ListAttachmentsRequest{
 		IdentifierType:  TINYID,
@@ -808,7 +917,7 @@ func TestDeleteAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err := deleteAttachmentRequestWithoutFileName.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("AttachmentId can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("AttachmentId cannot be empty.").Error())
 
 	deleteAttachmentRequestWithoutIdentifier := &DeleteAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -817,7 +926,7 @@ func TestDeleteAlertAttachmentRequest_Validate(t *testing.T) {
 	}
 	err = deleteAttachmentRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	deleteAttachmentRequest := &DeleteAttachmentRequest{
 		IdentifierType:  TINYID,
@@ -834,13 +943,37 @@ func TestAddResponderRequest_Validate(t *testing.T) {
 		IdentifierType:  ALERTID,
 		IdentifierValue: "Id",
 		Responder: Responder{
-			Type: "escalation",
+			Type: "service",
 			Name: "Test",
 		},
 	}
 	err := addResponderRequestWithInvalidResponderType.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Responder type must be user or team").Error())
+	assert.Equal(t, err.Error(), errors.New("responder.type should be one of user, team, escalation, schedule.").Error())
+
+	addResponderRequestWithInvalidSchedule := &AddResponderRequest{
+		IdentifierType:  ALERTID,
+		IdentifierValue: "Id",
+		Responder: Responder{
+			Type: ScheduleResponder,
+		},
+	}
+	err = addResponderRequestWithInvalidSchedule.Validate()
+
+	assert.Equal(t, "Schedule ID or name must be defined", err.Error())
+
+	addResponderRequestWithUsernameOfEscalation := &AddResponderRequest{
+		IdentifierType:  ALERTID,
+		IdentifierValue: "Id",
+		Responder: Responder{
+			Type:     EscalationResponder,
+			Name:     "payments-escalation",
+			Username: "usertest1",
+		},
+	}
+	err = addResponderRequestWithUsernameOfEscalation.Validate()
+
+	assert.Equal(t, "Username can not be defined for escalation responders", err.Error())
 
 	addResponderRequestWithInvalidUser := &AddResponderRequest{
 		IdentifierType: ALERTID,
@@ -871,7 +1004,7 @@ func TestAddResponderRequest_Validate(t *testing.T) {
 	}
 	err = addResponderRequestWithoutIdentifier.Validate()
 
-	assert.Equal(t, err.Error(), errors.New("Identifier can not be empty").Error())
+	assert.Equal(t, err.Error(), errors.New("IdentifierValue cannot be empty.").Error())
 
 	addResponderRequest := &AddResponderRequest{
 		IdentifierValue: "tiny1",
@@ -885,3 +1018,45 @@ func TestAddResponderRequest_Validate(t *testing.T) {
 
 	assert.Equal(t, err, nil)
 }
+
+func TestResponderMarshal(t *testing.T) {
+	body, err := json.Marshal(Responder{Type: ScheduleResponder, Name: "payments-schedule"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"type":"schedule","name":"payments-schedule"}`, string(body))
+
+	body, err = json.Marshal(Responder{Type: UserResponder, Username: "usertest1"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"type":"user","username":"usertest1"}`, string(body))
+}
+
+func TestRequestStatusResult(t *testing.T) {
+	result := &RequestStatusResult{}
+	err := json.Unmarshal([]byte(`{"success": true, "action": "Create", "isSuccess": true, "status": "Created alert", "alertId": "8418d193-2dab-4490-b331-8c02cdd196b7", "alias": "alias1"}`), result)
+	assert.Nil(t, err)
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, "8418d193-2dab-4490-b331-8c02cdd196b7", result.EntityId())
+	assert.Empty(t, result.FailureReason())
+
+	result = &RequestStatusResult{}
+	err = json.Unmarshal([]byte(`{"success": false, "action": "Acknowledge", "isSuccess": false, "status": "Alert does not exist"}`), result)
+	assert.Nil(t, err)
+	assert.False(t, result.Succeeded())
+	assert.Empty(t, result.EntityId())
+	assert.Equal(t, "Alert does not exist", result.FailureReason())
+}
+
+func TestReport(t *testing.T) {
+	result := &GetAlertResult{}
+	err := json.Unmarshal([]byte(`{"report": {"ackTime": 15702, "closeTime": 60000, "acknowledgedBy": "john@example.com", "closedBy": "jane@example.com"}}`), result)
+	assert.Nil(t, err)
+	assert.True(t, result.Report.IsAcknowledged())
+	assert.True(t, result.Report.IsClosed())
+	assert.Equal(t, 15702*time.Millisecond, result.Report.AckDuration())
+	assert.Equal(t, time.Minute, result.Report.CloseDuration())
+	assert.Equal(t, "john@example.com", result.Report.AcknowledgedBy)
+
+	report := Report{}
+	assert.False(t, report.IsAcknowledged())
+	assert.False(t, report.IsClosed())
+	assert.Equal(t, time.Duration(0), report.AckDuration())
+}
@@ -10,7 +10,7 @@ import (
 type AssignRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Owner           User   `json:"owner,omitempty"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
@@ -18,19 +18,18 @@ type AssignRequest struct {
 }
 
 func (r *AssignRequest) Validate() error {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
 	if r.Owner.ID == "" && r.Owner.Username == "" {
 		return errors.New("Owner ID or username must be defined")
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
 	return nil
 }
 
 func (r *AssignRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/assign"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/assign"
 
 }
 
@@ -54,3 +53,7 @@ func (r *AssignRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *AssignRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -4,28 +4,24 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type CloseAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
 	Note            string `json:"note,omitempty"`
 }
 
 func (r *CloseAlertRequest) Validate() error {
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *CloseAlertRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/close"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/close"
 
 }
 
@@ -33,6 +29,10 @@ func (r *CloseAlertRequest) Method() string {
 	return http.MethodPost
 }
 
+func (r *CloseAlertRequest) IsRemoval() bool {
+	return true
+}
+
 func (r *CloseAlertRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
@@ -49,3 +49,7 @@ func (r *CloseAlertRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *CloseAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
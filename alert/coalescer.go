@@ -0,0 +1,132 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Coalescer batches tag and detail additions to the same alert that arrive within a time window into a single
+// AddTags and a single AddDetails call. Updates are sent when the window of the alert elapses or when Flush is
+// called. Errors of updates sent in the background are passed to ErrorHandler.
+type Coalescer struct {
+	client       *Client
+	window       time.Duration
+	User         string
+	Source       string
+	ErrorHandler func(err error)
+
+	mux     sync.Mutex
+	pending map[coalescedAlert]*pendingUpdate
+}
+
+type coalescedAlert struct {
+	identifierType  AlertIdentifier
+	identifierValue string
+}
+
+type pendingUpdate struct {
+	tags    []string
+	tagSet  map[string]bool
+	details map[string]string
+	stop    func() bool
+}
+
+func NewCoalescer(client *Client, window time.Duration) *Coalescer {
+	return &Coalescer{
+		client:  client,
+		window:  window,
+		pending: make(map[coalescedAlert]*pendingUpdate),
+	}
+}
+
+func (c *Coalescer) AddTags(identifierType AlertIdentifier, identifierValue string, tags ...string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	update := c.pendingUpdate(coalescedAlert{identifierType, identifierValue})
+	for _, tag := range tags {
+		if !update.tagSet[tag] {
+			update.tagSet[tag] = true
+			update.tags = append(update.tags, tag)
+		}
+	}
+}
+
+// AddDetails queues details for the alert. Later values override earlier ones for the same key.
+func (c *Coalescer) AddDetails(identifierType AlertIdentifier, identifierValue string, details map[string]string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	update := c.pendingUpdate(coalescedAlert{identifierType, identifierValue})
+	for key, value := range details {
+		update.details[key] = value
+	}
+}
+
+func (c *Coalescer) pendingUpdate(alert coalescedAlert) *pendingUpdate {
+	update, ok := c.pending[alert]
+	if !ok {
+		update = &pendingUpdate{tagSet: make(map[string]bool), details: make(map[string]string)}
+		update.stop = c.client.client.Clock().AfterFunc(c.window, func() {
+			if err := c.flushAlert(context.Background(), alert); err != nil && c.ErrorHandler != nil {
+				c.ErrorHandler(err)
+			}
+		})
+		c.pending[alert] = update
+	}
+	return update
+}
+
+// Flush sends all pending updates immediately and returns the first error that occurred.
+func (c *Coalescer) Flush(ctx context.Context) error {
+	c.mux.Lock()
+	alerts := make([]coalescedAlert, 0, len(c.pending))
+	for alert, update := range c.pending {
+		update.stop()
+		alerts = append(alerts, alert)
+	}
+	c.mux.Unlock()
+
+	var firstErr error
+	for _, alert := range alerts {
+		if err := c.flushAlert(ctx, alert); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (c *Coalescer) flushAlert(ctx context.Context, alert coalescedAlert) error {
+	c.mux.Lock()
+	update, ok := c.pending[alert]
+	delete(c.pending, alert)
+	c.mux.Unlock()
+	if !ok {
+		return nil
+	}
+
+	if len(update.tags) > 0 {
+		_, err := c.client.AddTags(ctx, &AddTagsRequest{
+			IdentifierType:  alert.identifierType,
+			IdentifierValue: alert.identifierValue,
+			Tags:            update.tags,
+			User:            c.User,
+			Source:          c.Source,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(update.details) > 0 {
+		_, err := c.client.AddDetails(ctx, &AddDetailsRequest{
+			IdentifierType:  alert.identifierType,
+			IdentifierValue: alert.identifierValue,
+			Details:         update.details,
+			User:            c.User,
+			Source:          c.Source,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
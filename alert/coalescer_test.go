@@ -0,0 +1,70 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalescer(t *testing.T) {
+	var mux sync.Mutex
+	bodies := make(map[string][]map[string]interface{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make(map[string]interface{})
+		json.NewDecoder(r.Body).Decode(&body)
+		mux.Lock()
+		bodies[r.URL.Path] = append(bodies[r.URL.Path], body)
+		mux.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"result": "Request will be processed", "took": 0.1, "requestId": "r1"}`)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	coalescer := NewCoalescer(alertClient, time.Hour)
+	coalescer.Source = "enricher"
+	coalescer.AddTags(ALIAS, "a1", "env:prod", "db")
+	coalescer.AddTags(ALIAS, "a1", "db", "region:eu")
+	coalescer.AddDetails(ALIAS, "a1", map[string]string{"runbook": "v1"})
+	coalescer.AddDetails(ALIAS, "a1", map[string]string{"runbook": "v2", "sha": "abc"})
+	coalescer.AddTags(ALERTID, "a2", "x")
+
+	err = coalescer.Flush(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, len(bodies["/v2/alerts/a1/tags"]))
+	assert.Equal(t, []interface{}{"env:prod", "db", "region:eu"}, bodies["/v2/alerts/a1/tags"][0]["tags"])
+	assert.Equal(t, "enricher", bodies["/v2/alerts/a1/tags"][0]["source"])
+	assert.Equal(t, 1, len(bodies["/v2/alerts/a1/details"]))
+	assert.Equal(t, map[string]interface{}{"runbook": "v2", "sha": "abc"}, bodies["/v2/alerts/a1/details"][0]["details"])
+	assert.Equal(t, 1, len(bodies["/v2/alerts/a2/tags"]))
+	assert.Empty(t, bodies["/v2/alerts/a2/details"])
+
+	coalescer = NewCoalescer(alertClient, 10*time.Millisecond)
+	coalescer.AddTags(ALIAS, "a3", "late")
+	deadline := time.Now().Add(time.Second)
+	for {
+		mux.Lock()
+		sent := len(bodies["/v2/alerts/a3/tags"])
+		mux.Unlock()
+		if sent == 1 || time.Now().After(deadline) {
+			assert.Equal(t, 1, sent)
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,131 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+)
+
+const (
+	countWatcherSubsystem       = "alert-count-watcher"
+	defaultCountWatcherInterval = time.Minute
+)
+
+// Threshold is crossed when the alert count goes above Count, and crossed back when it drops to Count or below.
+type Threshold struct {
+	Name  string
+	Count int
+}
+
+type ThresholdEvent struct {
+	Threshold     Threshold
+	Count         int
+	PreviousCount int
+	// Exceeded is true when the count went above the threshold and false when it dropped back.
+	Exceeded bool
+}
+
+// CountWatcher counts the alerts matching a query on an interval and calls OnCross when the count crosses one of
+// its thresholds. OnCount, when set, is called with every count. Every poll publishes a client.SubsystemMetric.
+type CountWatcher struct {
+	client       *Client
+	request      CountAlertsRequest
+	interval     time.Duration
+	thresholds   []Threshold
+	OnCross      func(event ThresholdEvent)
+	OnCount      func(count int)
+	ErrorHandler func(err error)
+
+	mux      sync.Mutex
+	count    int
+	counted  bool
+	exceeded []bool
+}
+
+// NewCountWatcher creates a watcher polling on the interval, or every minute when the interval is not positive.
+func NewCountWatcher(client *Client, request *CountAlertsRequest, interval time.Duration, thresholds ...Threshold) *CountWatcher {
+	if interval <= 0 {
+		interval = defaultCountWatcherInterval
+	}
+	return &CountWatcher{
+		client:     client,
+		request:    *request,
+		interval:   interval,
+		thresholds: thresholds,
+		exceeded:   make([]bool, len(thresholds)),
+	}
+}
+
+// Start polls immediately and keeps polling until the context is done.
+func (w *CountWatcher) Start(ctx context.Context) {
+	go func() {
+		for {
+			w.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-w.client.client.Clock().After(w.interval):
+			}
+		}
+	}()
+}
+
+// Count returns the last count and whether a poll has succeeded yet.
+func (w *CountWatcher) Count() (int, bool) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.count, w.counted
+}
+
+func (w *CountWatcher) tick(ctx context.Context) {
+	clock := w.client.client.Clock()
+	start := clock.Now()
+	request := w.request
+	result, err := w.client.CountAlerts(ctx, &request)
+	metric := &client.SubsystemMetric{
+		Subsystem: countWatcherSubsystem,
+		Name:      w.request.Query,
+		Event:     client.TickEvent,
+		Duration:  int64(clock.Now().Sub(start) / time.Millisecond),
+	}
+	if err != nil {
+		metric.Event = client.FailureEvent
+		metric.Error = err
+		w.client.client.PublishSubsystemMetric(ctx, metric)
+		if w.ErrorHandler != nil && ctx.Err() == nil {
+			w.ErrorHandler(err)
+		}
+		return
+	}
+	w.client.client.PublishSubsystemMetric(ctx, metric)
+
+	w.mux.Lock()
+	previous := w.count
+	w.count = result.Count
+	w.counted = true
+	var events []ThresholdEvent
+	for i, threshold := range w.thresholds {
+		exceeded := result.Count > threshold.Count
+		if exceeded != w.exceeded[i] {
+			w.exceeded[i] = exceeded
+			events = append(events, ThresholdEvent{
+				Threshold:     threshold,
+				Count:         result.Count,
+				PreviousCount: previous,
+				Exceeded:      exceeded,
+			})
+		}
+	}
+	w.mux.Unlock()
+
+	if w.OnCount != nil {
+		w.OnCount(result.Count)
+	}
+	if w.OnCross != nil {
+		for _, event := range events {
+			w.OnCross(event)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountWatcherTick(t *testing.T) {
+	count := 5
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/alerts/count", r.URL.Path)
+		assert.Equal(t, "status:open AND priority:P1", r.URL.Query().Get("query"))
+		fmt.Fprintf(w, `{"data": {"count": %d}, "took": 0.1, "requestId": "rId"}`, count)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	watcher := NewCountWatcher(alertClient, &CountAlertsRequest{Query: "status:open AND priority:P1"}, time.Minute,
+		Threshold{Name: "warning", Count: 10}, Threshold{Name: "critical", Count: 20})
+	var events []ThresholdEvent
+	watcher.OnCross = func(event ThresholdEvent) {
+		events = append(events, event)
+	}
+
+	_, ok := watcher.Count()
+	assert.False(t, ok)
+	watcher.tick(context.Background())
+	assert.Empty(t, events)
+	last, ok := watcher.Count()
+	assert.True(t, ok)
+	assert.Equal(t, 5, last)
+
+	count = 25
+	watcher.tick(context.Background())
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, "warning", events[0].Threshold.Name)
+	assert.Equal(t, "critical", events[1].Threshold.Name)
+	assert.True(t, events[1].Exceeded)
+	assert.Equal(t, 5, events[1].PreviousCount)
+
+	watcher.tick(context.Background())
+	assert.Equal(t, 2, len(events))
+
+	count = 15
+	watcher.tick(context.Background())
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, "critical", events[2].Threshold.Name)
+	assert.False(t, events[2].Exceeded)
+	assert.Equal(t, 15, events[2].Count)
+}
+
+func TestCountWatcherDefaultInterval(t *testing.T) {
+	watcher := NewCountWatcher(&Client{}, &CountAlertsRequest{Query: "status:open"}, 0)
+	assert.Equal(t, defaultCountWatcherInterval, watcher.interval)
+	watcher = NewCountWatcher(&Client{}, &CountAlertsRequest{Query: "status:open"}, -time.Second)
+	assert.Equal(t, defaultCountWatcherInterval, watcher.interval)
+}
@@ -1,12 +1,10 @@
 package alert
 
 import (
-	"errors"
 	"io"
 	"net/http"
 	"os"
 	"path"
-	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 )
@@ -14,44 +12,39 @@ import (
 type CreateAlertAttachmentRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	FileName        string
-	FilePath        string
+	IdentifierValue string `validate:"required"`
+	FileName        string `validate:"required"`
+	FilePath        string `validate:"required"`
 	User            string
 	IndexFile       string
 }
 
-func (r *CreateAlertAttachmentRequest) Metadata(ar client.ApiRequest) map[string]interface{} {
-	headers := make(map[string]interface{})
-
-	formDataMap := make(map[string]io.Reader)
-	filePath := path.Join(r.FilePath, r.FileName)
-	file, _ := os.Open(filePath)
-
-	formDataMap["file"] = file
-	formDataMap["user"] = strings.NewReader(r.User)
-	formDataMap["indexFile"] = strings.NewReader(r.IndexFile)
-	headers["form-data-values"] = formDataMap
-
-	return headers
+func (r *CreateAlertAttachmentRequest) File() (string, io.ReadCloser, error) {
+	file, err := os.Open(path.Join(r.FilePath, r.FileName))
+	if err != nil {
+		return "", nil, err
+	}
+	return r.FileName, file, nil
 }
 
-func (r *CreateAlertAttachmentRequest) Validate() error {
-	if r.FileName == "" {
-		return errors.New("FileName can not be empty")
-	}
-	if r.FilePath == "" {
-		return errors.New("FilePath can not be empty")
+func (r *CreateAlertAttachmentRequest) FormFields() map[string]string {
+	fields := make(map[string]string)
+	if r.User != "" {
+		fields["user"] = r.User
 	}
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	if r.IndexFile != "" {
+		fields["indexFile"] = r.IndexFile
 	}
-	return nil
+	return fields
+}
+
+func (r *CreateAlertAttachmentRequest) Validate() error {
+	return client.ValidateStruct(r)
 }
 
 func (r *CreateAlertAttachmentRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/attachments"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/attachments"
 }
 
 func (r *CreateAlertAttachmentRequest) Method() string {
@@ -74,3 +67,7 @@ func (r *CreateAlertAttachmentRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *CreateAlertAttachmentRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, nil)
+}
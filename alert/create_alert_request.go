@@ -1,7 +1,6 @@
 package alert
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
@@ -9,26 +8,23 @@ import (
 
 type CreateAlertRequest struct {
 	client.BaseRequest
-	Message     string            `json:"message"`
+	Message     string            `json:"message" validate:"required"`
 	Alias       string            `json:"alias,omitempty"`
 	Description string            `json:"description,omitempty"`
-	Responders  []Responder       `json:"responders,omitempty"`
-	VisibleTo   []Responder       `json:"visibleTo,omitempty"`
-	Actions     []string          `json:"actions,omitempty"`
-	Tags        []string          `json:"tags,omitempty"`
+	Responders  []Responder       `json:"responders,omitempty" validate:"max=50,nested"`
+	VisibleTo   []Responder       `json:"visibleTo,omitempty" validate:"max=50,nested"`
+	Actions     []string          `json:"actions,omitempty" validate:"max=10"`
+	Tags        []string          `json:"tags,omitempty" validate:"max=20"`
 	Details     map[string]string `json:"details,omitempty"`
 	Entity      string            `json:"entity,omitempty"`
 	Source      string            `json:"source,omitempty"`
-	Priority    Priority          `json:"priority,omitempty"`
+	Priority    Priority          `json:"priority,omitempty" validate:"oneof=P1 P2 P3 P4 P5"`
 	User        string            `json:"user,omitempty"`
 	Note        string            `json:"note,omitempty"`
 }
 
 func (r *CreateAlertRequest) Validate() error {
-	if r.Message == "" {
-		return errors.New("message can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *CreateAlertRequest) ResourcePath() string {
@@ -39,3 +35,7 @@ func (r *CreateAlertRequest) ResourcePath() string {
 func (r *CreateAlertRequest) Method() string {
 	return http.MethodPost
 }
+
+func (r *CreateAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -9,26 +9,20 @@ import (
 
 type CreateSavedSearchRequest struct {
 	client.BaseRequest
-	Name        string `json:"name,omitempty"`
-	Query       string `json:"query,omitempty"`
+	Name        string `json:"name,omitempty" validate:"required"`
+	Query       string `json:"query,omitempty" validate:"required"`
 	Owner       User   `json:"owner,omitempty"`
 	Description string `json:"description,omitempty"`
 	Teams       []Team `json:"teams,omitempty"`
 }
 
 func (r *CreateSavedSearchRequest) Validate() error {
-	if r.Name == "" {
-		return errors.New("Name can not be empty")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-
-	if r.Query == "" {
-		return errors.New("Query can not be empty")
-	}
-
 	if r.Owner.ID == "" && r.Owner.Username == "" {
 		return errors.New("Owner can not be empty")
 	}
-
 	return nil
 }
 
@@ -9,21 +9,17 @@ import (
 type DeleteAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Source          string
 }
 
 func (r *DeleteAlertRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *DeleteAlertRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *DeleteAlertRequest) Method() string {
@@ -50,3 +46,7 @@ func (r *DeleteAlertRequest) RequestParams() map[string]string {
 
 	return params
 }
+
+func (r *DeleteAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(nil, &r.Source)
+}
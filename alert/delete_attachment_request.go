@@ -4,31 +4,23 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type DeleteAttachmentRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	AttachmentId    string
+	IdentifierValue string `validate:"required"`
+	AttachmentId    string `validate:"required"`
 	User            string
 }
 
 func (r *DeleteAttachmentRequest) Validate() error {
-	if r.AttachmentId == "" {
-		return errors.New("AttachmentId can not be empty")
-	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *DeleteAttachmentRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/attachments/" + r.AttachmentId
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/attachments/" + client.PathSegment(r.AttachmentId)
 }
 
 func (r *DeleteAttachmentRequest) Method() string {
@@ -56,3 +48,7 @@ func (r *DeleteAttachmentRequest) RequestParams() map[string]string {
 
 	return params
 }
+
+func (r *DeleteAttachmentRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, nil)
+}
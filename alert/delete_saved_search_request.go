@@ -9,20 +9,16 @@ import (
 type DeleteSavedSearchRequest struct {
 	client.BaseRequest
 	IdentifierType  SearchIdentifierType
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 }
 
 func (r *DeleteSavedSearchRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *DeleteSavedSearchRequest) ResourcePath() string {
 
-	return "/v2/alerts/saved-searches/" + r.IdentifierValue
+	return "/v2/alerts/saved-searches/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *DeleteSavedSearchRequest) Method() string {
@@ -10,7 +10,7 @@ import (
 type EscalateToNextRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string     `validate:"required"`
 	Escalation      Escalation `json:"escalation,omitempty"`
 	User            string     `json:"user,omitempty"`
 	Source          string     `json:"source,omitempty"`
@@ -18,19 +18,18 @@ type EscalateToNextRequest struct {
 }
 
 func (r *EscalateToNextRequest) Validate() error {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
 	if r.Escalation.ID == "" && r.Escalation.Name == "" {
 		return errors.New("Escalation ID or name must be defined")
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
 	return nil
 }
 
 func (r *EscalateToNextRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/escalate"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/escalate"
 
 }
 
@@ -54,3 +53,7 @@ func (r *EscalateToNextRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *EscalateToNextRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
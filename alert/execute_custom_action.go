@@ -4,32 +4,25 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type ExecuteCustomActionAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Action          string
+	IdentifierValue string `validate:"required"`
+	Action          string `validate:"required"`
 	User            string `json:"user,omitempty"`
 	Source          string `json:"source,omitempty"`
 	Note            string `json:"note,omitempty"`
 }
 
 func (r *ExecuteCustomActionAlertRequest) Validate() error {
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	if r.Action == "" {
-		return errors.New("Action can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *ExecuteCustomActionAlertRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/actions/" + r.Action
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/actions/" + client.PathSegment(r.Action)
 
 }
 
@@ -53,3 +46,7 @@ func (r *ExecuteCustomActionAlertRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *ExecuteCustomActionAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
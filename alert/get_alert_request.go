@@ -2,6 +2,7 @@ package alert
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 )
@@ -9,19 +10,18 @@ import (
 type GetAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
+	// Fields limits the returned alert to the given fields, all fields are returned when empty.
+	Fields  []string
+	Expands []ExpandType
 }
 
 func (r *GetAlertRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *GetAlertRequest) ResourcePath() string {
-	return "/v2/alerts/" + r.IdentifierValue
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetAlertRequest) Method() string {
@@ -42,5 +42,13 @@ func (r *GetAlertRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 
 	}
+
+	if len(r.Fields) > 0 {
+		params["fields"] = strings.Join(r.Fields, ",")
+	}
+
+	if len(r.Expands) > 0 {
+		params["expand"] = joinExpands(r.Expands)
+	}
 	return params
 }
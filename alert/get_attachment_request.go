@@ -4,30 +4,22 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type GetAttachmentRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	AttachmentId    string
+	IdentifierValue string `validate:"required"`
+	AttachmentId    string `validate:"required"`
 }
 
 func (r *GetAttachmentRequest) Validate() error {
-	if r.AttachmentId == "" {
-		return errors.New("AttachmentId can not be empty")
-	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *GetAttachmentRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/attachments/" + r.AttachmentId
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/attachments/" + client.PathSegment(r.AttachmentId)
 }
 
 func (r *GetAttachmentRequest) Method() string {
@@ -4,26 +4,25 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type GetRequestStatusRequest struct {
 	client.BaseRequest
-	RequestId string `json:"requestId,omitempty"`
+	RequestId string `json:"requestId,omitempty" validate:"required"`
 }
 
 func (r *GetRequestStatusRequest) Validate() error {
-	if r.RequestId == "" {
-		return errors.New("RequestId can not be empty")
-	}
-
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *GetRequestStatusRequest) ResourcePath() string {
-	return "/v2/alerts/requests/" + r.RequestId
+	return "/v2/alerts/requests/" + client.PathSegment(r.RequestId)
 }
 
 func (r *GetRequestStatusRequest) Method() string {
 	return http.MethodGet
 }
+
+func (r *GetRequestStatusRequest) AsyncRequestId() string {
+	return r.RequestId
+}
@@ -9,20 +9,16 @@ import (
 type GetSavedSearchRequest struct {
 	client.BaseRequest
 	IdentifierType  SearchIdentifierType
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 }
 
 func (r *GetSavedSearchRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *GetSavedSearchRequest) ResourcePath() string {
 
-	return "/v2/alerts/saved-searches/" + r.IdentifierValue
+	return "/v2/alerts/saved-searches/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetSavedSearchRequest) Method() string {
@@ -2,7 +2,6 @@ package alert
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 )
@@ -10,7 +9,7 @@ import (
 type ListAlertLogsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Offset          int
 	Direction       RequestDirection
 	Order           Order
@@ -18,15 +17,18 @@ type ListAlertLogsRequest struct {
 }
 
 func (r *ListAlertLogsRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
+	if err := client.ValidateStruct(r); err != nil {
 		return err
 	}
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListAlertLogsRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: int(r.Limit), Offset: r.Offset, Order: string(r.Order)}
 }
 
 func (r *ListAlertLogsRequest) ResourcePath() string {
-	return "/v2/alerts/" + r.IdentifierValue + "/logs"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/logs"
 }
 
 func (r *ListAlertLogsRequest) Method() string {
@@ -47,15 +49,7 @@ func (r *ListAlertLogsRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
-
-	if r.Order == Asc {
-		params["order"] = "asc"
-	} else if r.Order == Desc {
-		params["order"] = "desc"
-	}
+	r.listParams().AddTo(params)
 
 	if r.Direction == NEXT {
 		params["direction"] = "next"
@@ -63,9 +57,5 @@ func (r *ListAlertLogsRequest) RequestParams() map[string]string {
 		params["direction"] = "prev"
 	}
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(int(r.Limit))
-	}
-
 	return params
 }
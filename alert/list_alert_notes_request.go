@@ -2,7 +2,6 @@ package alert
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 )
@@ -10,7 +9,7 @@ import (
 type ListAlertNotesRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Offset          string
 	Direction       RequestDirection
 	Order           Order
@@ -18,15 +17,19 @@ type ListAlertNotesRequest struct {
 }
 
 func (r *ListAlertNotesRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
+	if err := client.ValidateStruct(r); err != nil {
 		return err
 	}
-	return nil
+	return r.listParams().Validate()
+}
+
+// listParams leaves out the offset, which is a cursor for notes.
+func (r *ListAlertNotesRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: int(r.Limit), Order: string(r.Order)}
 }
 
 func (r *ListAlertNotesRequest) ResourcePath() string {
-	return "/v2/alerts/" + r.IdentifierValue + "/notes"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/notes"
 }
 
 func (r *ListAlertNotesRequest) Method() string {
@@ -47,25 +50,16 @@ func (r *ListAlertNotesRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
+	r.listParams().AddTo(params)
 	if r.Offset != "" {
 		params["offset"] = r.Offset
 	}
 
-	if r.Order == Asc {
-		params["order"] = "asc"
-	} else if r.Order == Desc {
-		params["order"] = "desc"
-	}
-
 	if r.Direction == NEXT {
 		params["direction"] = "next"
 	} else if r.Direction == PREV {
 		params["direction"] = "prev"
 	}
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(int(r.Limit))
-	}
-
 	return params
 }
@@ -9,20 +9,16 @@ import (
 type ListAlertRecipientRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 }
 
 func (r *ListAlertRecipientRequest) Validate() error {
-	err := validateIdentifier(r.IdentifierValue)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *ListAlertRecipientRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/recipients"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/recipients"
 }
 
 func (r *ListAlertRecipientRequest) Method() string {
@@ -2,7 +2,7 @@ package alert
 
 import (
 	"net/http"
-	"strconv"
+	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 )
@@ -16,11 +16,18 @@ type ListAlertRequest struct {
 	Query                string
 	SearchIdentifier     string
 	SearchIdentifierType SearchIdentifierType
+	// Fields limits the returned alerts to the given fields, all fields are returned when empty.
+	Fields  []string
+	Expands []ExpandType
 }
 
 func (r *ListAlertRequest) Validate() error {
 
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListAlertRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Sort: string(r.Sort), Order: string(r.Order), Query: r.Query}
 }
 
 func (r *ListAlertRequest) ResourcePath() string {
@@ -35,22 +42,7 @@ func (r *ListAlertRequest) Method() string {
 func (r *ListAlertRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
-
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-
-	if r.Sort != "" {
-		params["sort"] = string(r.Sort)
-	}
-
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
-
-	if r.Query != "" {
-		params["query"] = r.Query
-	}
+	r.listParams().AddTo(params)
 
 	if r.SearchIdentifier != "" {
 		params["searchIdentifier"] = r.SearchIdentifier
@@ -60,8 +52,12 @@ func (r *ListAlertRequest) RequestParams() map[string]string {
 		params["searchIdentifierType"] = string(r.SearchIdentifierType)
 	}
 
-	if r.Order != "" {
-		params["order"] = string(r.Order)
+	if len(r.Fields) > 0 {
+		params["fields"] = strings.Join(r.Fields, ",")
+	}
+
+	if len(r.Expands) > 0 {
+		params["expand"] = joinExpands(r.Expands)
 	}
 
 	return params
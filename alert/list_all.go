@@ -0,0 +1,140 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const listAllPageSize = 100
+
+// ListAllOptions controls how ListAll handles alerts changing while it pages through them.
+type ListAllOptions struct {
+	// RetryWithAnchor restarts the listing sorted by creation time and paged by a creation time anchor when drift
+	// is detected, instead of returning a PaginationDriftWarning.
+	RetryWithAnchor bool
+}
+
+// PaginationDriftWarning is returned together with the listed alerts when alerts were created or deleted while
+// paging through them, so the listing may contain duplicates (removed from the result) or miss alerts.
+type PaginationDriftWarning struct {
+	// DuplicateIds are the ids of alerts that were seen on more than one page.
+	DuplicateIds []string
+	// MayMissAlerts is set when alerts shifted towards earlier pages, so some alerts may have been skipped.
+	MayMissAlerts bool
+}
+
+func (w *PaginationDriftWarning) Error() string {
+	return fmt.Sprintf("Alerts changed while listing them: %d duplicates were removed, alerts may be missing: %t",
+		len(w.DuplicateIds), w.MayMissAlerts)
+}
+
+// ListAll pages through the alerts matching the request, starting at its offset. Consecutive pages overlap by one
+// alert to detect alerts shifting between pages because of concurrent changes. The limit of the request is used as
+// the page size.
+func (c *Client) ListAll(ctx context.Context, req *ListAlertRequest, options ListAllOptions) ([]Alert, error) {
+	pageRequest := *req
+	if pageRequest.Limit < 2 {
+		pageRequest.Limit = listAllPageSize
+	}
+
+	var alerts []Alert
+	seen := make(map[string]bool)
+	var warning *PaginationDriftWarning
+	for {
+		result, err := c.List(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+		overlap := ""
+		if len(alerts) > 0 {
+			overlap = alerts[len(alerts)-1].Id
+		}
+		for i, alert := range result.Alerts {
+			if i == 0 && overlap != "" && alert.Id != overlap {
+				if warning == nil {
+					warning = &PaginationDriftWarning{}
+				}
+				// alerts shifted towards earlier pages when the expected overlap was skipped
+				warning.MayMissAlerts = warning.MayMissAlerts || !seen[alert.Id]
+			}
+			if seen[alert.Id] {
+				if alert.Id != overlap {
+					if warning == nil {
+						warning = &PaginationDriftWarning{}
+					}
+					warning.DuplicateIds = append(warning.DuplicateIds, alert.Id)
+				}
+				continue
+			}
+			seen[alert.Id] = true
+			alerts = append(alerts, alert)
+		}
+
+		if len(result.Alerts) < pageRequest.Limit {
+			break
+		}
+		// the next page starts with the last alert of this one
+		pageRequest.Offset += pageRequest.Limit - 1
+	}
+
+	if warning == nil {
+		return alerts, nil
+	}
+	if options.RetryWithAnchor {
+		return c.listAllAnchored(ctx, req)
+	}
+	return alerts, warning
+}
+
+// listAllAnchored pages through alerts in creation order, restricting each page to alerts created at or after the
+// newest alert seen so far. Alerts created or deleted concurrently don't shift the remaining pages.
+func (c *Client) listAllAnchored(ctx context.Context, req *ListAlertRequest) ([]Alert, error) {
+	pageRequest := *req
+	if pageRequest.Limit < 2 {
+		pageRequest.Limit = listAllPageSize
+	}
+	pageRequest.Sort = CreatedAt
+	pageRequest.Order = Asc
+	pageRequest.Offset = 0
+
+	var alerts []Alert
+	seen := make(map[string]bool)
+	var anchor time.Time
+	atAnchor := 0
+	for {
+		if !anchor.IsZero() {
+			pageRequest.Query = anchoredQuery(req.Query, anchor)
+			pageRequest.Offset = atAnchor
+		}
+		result, err := c.List(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, alert := range result.Alerts {
+			// the API compares creation times with millisecond precision
+			createdAt := alert.CreatedAt.Truncate(time.Millisecond)
+			if !createdAt.Equal(anchor) {
+				anchor = createdAt
+				atAnchor = 0
+			}
+			atAnchor++
+			if !seen[alert.Id] {
+				seen[alert.Id] = true
+				alerts = append(alerts, alert)
+			}
+		}
+		if len(result.Alerts) < pageRequest.Limit {
+			return alerts, nil
+		}
+	}
+}
+
+func anchoredQuery(query string, anchor time.Time) string {
+	condition := "createdAt >= " + strconv.FormatInt(anchor.UnixNano()/int64(time.Millisecond), 10)
+	if query == "" {
+		return condition
+	}
+	return "(" + query + ") AND " + condition
+}
@@ -0,0 +1,104 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+type alertListServer struct {
+	alerts        []Alert
+	requests      int
+	insertOnFirst bool
+}
+
+func (s *alertListServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests++
+	query := r.URL.Query()
+	alerts := append([]Alert{}, s.alerts...)
+	if s.insertOnFirst && s.requests == 1 {
+		s.alerts = append(s.alerts, Alert{Id: "new", CreatedAt: time.Unix(100, 0)})
+	}
+
+	if condition := query.Get("query"); condition != "" {
+		anchor, _ := strconv.ParseInt(strings.TrimPrefix(condition, "createdAt >= "), 10, 64)
+		var filtered []Alert
+		for _, alert := range alerts {
+			if alert.CreatedAt.UnixNano()/int64(time.Millisecond) >= anchor {
+				filtered = append(filtered, alert)
+			}
+		}
+		alerts = filtered
+	}
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if query.Get("order") == "asc" {
+			return alerts[i].CreatedAt.Before(alerts[j].CreatedAt)
+		}
+		return alerts[i].CreatedAt.After(alerts[j].CreatedAt)
+	})
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+	if offset > len(alerts) {
+		offset = len(alerts)
+	}
+	end := offset + limit
+	if end > len(alerts) {
+		end = len(alerts)
+	}
+	data, _ := json.Marshal(alerts[offset:end])
+	fmt.Fprintf(w, `{"data": %s, "took": 0.1, "requestId": "rId"}`, data)
+}
+
+func newAlertListServer(count int) *alertListServer {
+	server := &alertListServer{}
+	for i := 0; i < count; i++ {
+		server.alerts = append(server.alerts, Alert{Id: "a" + strconv.Itoa(i), CreatedAt: time.Unix(int64(i), 0)})
+	}
+	return server
+}
+
+func TestListAll(t *testing.T) {
+	server := newAlertListServer(7)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	alerts, err := alertClient.ListAll(context.Background(), &ListAlertRequest{Limit: 3}, ListAllOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 7, len(alerts))
+	assert.Equal(t, "a6", alerts[0].Id)
+	assert.Equal(t, "a0", alerts[6].Id)
+
+	server.insertOnFirst = true
+	server.requests = 0
+	alerts, err = alertClient.ListAll(context.Background(), &ListAlertRequest{Limit: 3}, ListAllOptions{})
+	warning, ok := err.(*PaginationDriftWarning)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a5"}, warning.DuplicateIds)
+	assert.False(t, warning.MayMissAlerts)
+	assert.Equal(t, 7, len(alerts))
+
+	server = newAlertListServer(7)
+	server.insertOnFirst = true
+	ts.Config.Handler = server
+	alerts, err = alertClient.ListAll(context.Background(), &ListAlertRequest{Limit: 3}, ListAllOptions{RetryWithAnchor: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(alerts))
+	assert.Equal(t, "a0", alerts[0].Id)
+	assert.Equal(t, "new", alerts[7].Id)
+}
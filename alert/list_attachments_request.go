@@ -4,25 +4,21 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type ListAttachmentsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 }
 
 func (r *ListAttachmentsRequest) Validate() error {
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *ListAttachmentsRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/attachments"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/attachments"
 }
 
 func (r *ListAttachmentsRequest) Method() string {
@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"regexp"
+	"strings"
+)
+
+// LogAction is the kind of change an alert log describes.
+type LogAction string
+
+const (
+	LogCreate            LogAction = "create"
+	LogAcknowledge       LogAction = "acknowledge"
+	LogUnacknowledge     LogAction = "unacknowledge"
+	LogClose             LogAction = "close"
+	LogSnooze            LogAction = "snooze"
+	LogEscalate          LogAction = "escalate"
+	LogAssign            LogAction = "assign"
+	LogTakeOwnership     LogAction = "take-ownership"
+	LogAddNote           LogAction = "add-note"
+	LogAddTags           LogAction = "add-tags"
+	LogRemoveTags        LogAction = "remove-tags"
+	LogAddDetails        LogAction = "add-details"
+	LogRemoveDetails     LogAction = "remove-details"
+	LogAddResponder      LogAction = "add-responder"
+	LogAddTeam           LogAction = "add-team"
+	LogUpdatePriority    LogAction = "update-priority"
+	LogUpdateMessage     LogAction = "update-message"
+	LogUpdateDescription LogAction = "update-description"
+	LogExecuteAction     LogAction = "execute-action"
+	LogDeduplicate       LogAction = "deduplicate"
+)
+
+// LogEvent is an alert log parsed into who changed what. Fields the log doesn't mention are left empty; Actor falls
+// back to the owner of the log.
+type LogEvent struct {
+	Action   LogAction
+	Actor    string
+	Source   string
+	Target   string
+	OldValue string
+	NewValue string
+}
+
+type logPattern struct {
+	action LogAction
+	regexp *regexp.Regexp
+}
+
+// logBy and logVia are the optional actor and source suffixes most alert logs end with.
+const (
+	logBy  = `(?: by \[?(?P<actor>[^\]]+?)\]?)?`
+	logVia = `(?: via (?P<source>.+?))?`
+)
+
+func newLogPattern(action LogAction, expr string) logPattern {
+	return logPattern{action: action, regexp: regexp.MustCompile(`(?i)^` + expr + `\.?$`)}
+}
+
+var alertLogPatterns = []logPattern{
+	newLogPattern(LogCreate, `Alert created`+logBy+logVia),
+	newLogPattern(LogAcknowledge, `Alert acknowledged`+logBy+logVia),
+	newLogPattern(LogUnacknowledge, `Alert unacknowledged`+logBy+logVia),
+	newLogPattern(LogClose, `Alert closed`+logBy+logVia),
+	newLogPattern(LogSnooze, `Alert snoozed(?: until \[?(?P<new>[^\]]+?)\]?)?`+logBy+logVia),
+	newLogPattern(LogEscalate, `Alert escalated to (?:the )?next (?:level|order)`+logBy+logVia),
+	newLogPattern(LogAssign, `(?:Alert )?(?:assigned|ownership assigned) to \[?(?P<target>[^\]]+?)\]?`+logBy+logVia),
+	newLogPattern(LogTakeOwnership, `(?:Alert )?ownership taken`+logBy+logVia),
+	newLogPattern(LogAddNote, `(?:Alert )?note added`+logBy+logVia),
+	newLogPattern(LogAddTags, `(?:Added|Add) tags? \[(?P<new>[^\]]*)\]`+logBy+logVia),
+	newLogPattern(LogRemoveTags, `(?:Removed|Remove) tags? \[(?P<old>[^\]]*)\]`+logBy+logVia),
+	newLogPattern(LogAddDetails, `(?:Added|Add) details? \[(?P<new>[^\]]*)\]`+logBy+logVia),
+	newLogPattern(LogRemoveDetails, `(?:Removed|Remove) details? \[(?P<old>[^\]]*)\]`+logBy+logVia),
+	newLogPattern(LogAddResponder, `(?:Added|Add) responder \[?(?P<target>[^\]]+?)\]?`+logBy+logVia),
+	newLogPattern(LogAddTeam, `(?:Added|Add) team \[?(?P<target>[^\]]+?)\]?`+logBy+logVia),
+	newLogPattern(LogUpdatePriority, `(?:Alert )?priority (?:changed|updated) from \[?(?P<old>[^\]]*?)\]? to \[?(?P<new>[^\]]*?)\]?`+logBy+logVia),
+	newLogPattern(LogUpdateMessage, `(?:Alert )?message (?:changed|updated) from \[(?P<old>.*)\] to \[(?P<new>.*)\]`+logBy+logVia),
+	newLogPattern(LogUpdateDescription, `(?:Alert )?description (?:changed|updated)(?: from \[(?P<old>.*)\] to \[(?P<new>.*)\])?`+logBy+logVia),
+	newLogPattern(LogExecuteAction, `(?:Executed|Execute) \[?(?P<target>[^\]]+?)\]? action`+logBy+logVia),
+	newLogPattern(LogDeduplicate, `Alert (?:count increased|deduplicated)(?: to \[?(?P<new>[^\]]+?)\]?)?`+logBy+logVia),
+}
+
+// ParseLog parses the log with the known Opsgenie alert log formats. The formats are not documented by Opsgenie and
+// may change, so false is returned for logs that match none of them; use Log as is then.
+func (l AlertLog) ParseLog() (LogEvent, bool) {
+	return parseLog(strings.TrimSpace(l.Log), l.Owner, alertLogPatterns)
+}
+
+func parseLog(log string, owner string, patterns []logPattern) (LogEvent, bool) {
+	for _, pattern := range patterns {
+		match := pattern.regexp.FindStringSubmatch(log)
+		if match == nil {
+			continue
+		}
+		event := LogEvent{Action: pattern.action, Actor: owner}
+		for i, name := range pattern.regexp.SubexpNames() {
+			if match[i] == "" {
+				continue
+			}
+			switch name {
+			case "actor":
+				event.Actor = match[i]
+			case "source":
+				event.Source = match[i]
+			case "target":
+				event.Target = match[i]
+			case "old":
+				event.OldValue = match[i]
+			case "new":
+				event.NewValue = match[i]
+			}
+		}
+		return event, true
+	}
+	return LogEvent{}, false
+}
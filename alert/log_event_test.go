@@ -0,0 +1,33 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertLogParseLog(t *testing.T) {
+	tests := []struct {
+		log   string
+		event LogEvent
+	}{
+		{"Alert created via API[Default API]", LogEvent{Action: LogCreate, Actor: "owner@example.com", Source: "API[Default API]"}},
+		{"Alert acknowledged by john@example.com via web.", LogEvent{Action: LogAcknowledge, Actor: "john@example.com", Source: "web"}},
+		{"Alert closed by [jane@example.com]", LogEvent{Action: LogClose, Actor: "jane@example.com"}},
+		{"Alert snoozed until [2019-12-24T10:00:00Z] via web", LogEvent{Action: LogSnooze, Actor: "owner@example.com", Source: "web", NewValue: "2019-12-24T10:00:00Z"}},
+		{"Alert assigned to [ops@example.com] by john@example.com", LogEvent{Action: LogAssign, Actor: "john@example.com", Target: "ops@example.com"}},
+		{"Added tags [db,critical] via API", LogEvent{Action: LogAddTags, Actor: "owner@example.com", Source: "API", NewValue: "db,critical"}},
+		{"Removed tags [db]", LogEvent{Action: LogRemoveTags, Actor: "owner@example.com", OldValue: "db"}},
+		{"Priority changed from [P3] to [P1] by john@example.com", LogEvent{Action: LogUpdatePriority, Actor: "john@example.com", OldValue: "P3", NewValue: "P1"}},
+		{"Message updated from [disk [sda] full] to [disk full]", LogEvent{Action: LogUpdateMessage, Actor: "owner@example.com", OldValue: "disk [sda] full", NewValue: "disk full"}},
+		{"Executed [Restart] action via web", LogEvent{Action: LogExecuteAction, Actor: "owner@example.com", Source: "web", Target: "Restart"}},
+	}
+	for _, test := range tests {
+		event, ok := AlertLog{Log: test.log, Owner: "owner@example.com"}.ParseLog()
+		assert.True(t, ok, test.log)
+		assert.Equal(t, test.event, event, test.log)
+	}
+
+	_, ok := AlertLog{Log: "Something unexpected happened"}.ParseLog()
+	assert.False(t, ok)
+}
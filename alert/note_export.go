@@ -0,0 +1,56 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ListAllNotes pages through the notes of an alert, starting at the offset given in the request, and returns all
+// of them.
+func (c *Client) ListAllNotes(ctx context.Context, req *ListAlertNotesRequest) ([]AlertNote, error) {
+	pageRequest := *req
+	if pageRequest.Direction == "" {
+		pageRequest.Direction = NEXT
+	}
+
+	notes := make([]AlertNote, 0)
+	for {
+		result, err := c.ListAlertNotes(ctx, &pageRequest)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, result.AlertLog...)
+
+		if len(result.AlertLog) == 0 || result.Paging[string(pageRequest.Direction)] == "" {
+			return notes, nil
+		}
+		offset := result.AlertLog[len(result.AlertLog)-1].Offset
+		if offset == "" || offset == pageRequest.Offset {
+			return notes, nil
+		}
+		pageRequest.Offset = offset
+	}
+}
+
+// ExportNotes writes the full note history of an alert to the writer, one note per line with its creation time in
+// RFC3339 format and its owner, oldest note first.
+func (c *Client) ExportNotes(ctx context.Context, identifierType AlertIdentifier, identifierValue string, w io.Writer) error {
+	notes, err := c.ListAllNotes(ctx, &ListAlertNotesRequest{
+		IdentifierType:  identifierType,
+		IdentifierValue: identifierValue,
+		Order:           Asc,
+		Direction:       NEXT,
+	})
+	if err != nil {
+		return err
+	}
+	for _, note := range notes {
+		_, err := fmt.Fprintf(w, "%s %s: %s\n", note.CreatedAt.UTC().Format(time.RFC3339), note.Owner, note.Note)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
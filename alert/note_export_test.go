@@ -0,0 +1,48 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportNotes(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/alerts/my-alias/notes", r.URL.Path)
+		assert.Equal(t, "alias", r.URL.Query().Get("identifierType"))
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"data": [
+				{"note": "first", "owner": "john@example.com", "createdAt": "2019-03-01T10:00:00Z", "offset": "1"},
+				{"note": "second", "owner": "jane@example.com", "createdAt": "2019-03-01T10:05:00Z", "offset": "2"}
+			], "paging": {"next": "https://api.opsgenie.com/v2/alerts/my-alias/notes?offset=2"}, "took": 0.1, "requestId": "r1"}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [
+				{"note": "third", "owner": "System", "createdAt": "2019-03-01T11:00:00Z", "offset": "3"}
+			], "paging": {}, "took": 0.1, "requestId": "r2"}`)
+		default:
+			t.Fatal("unexpected offset")
+		}
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	buf := &bytes.Buffer{}
+	err = alertClient.ExportNotes(context.Background(), ALIAS, "my-alias", buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "2019-03-01T10:00:00Z john@example.com: first\n"+
+		"2019-03-01T10:05:00Z jane@example.com: second\n"+
+		"2019-03-01T11:00:00Z System: third\n", buf.String())
+}
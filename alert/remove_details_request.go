@@ -2,6 +2,7 @@ package alert
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -10,27 +11,33 @@ import (
 type RemoveDetailsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Keys            string
-	Source          string
-	User            string
-	Note            string
+	// KeyList holds the keys to remove as a list, an alternative to the comma separated Keys.
+	KeyList []string
+	Source  string
+	User    string
+	Note    string
 }
 
 func (r *RemoveDetailsRequest) Validate() error {
-	if r.Keys == "" {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
+	if r.Keys == "" && len(r.KeyList) == 0 {
 		return errors.New("Keys can not be empty")
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	for _, key := range r.KeyList {
+		if err := validateDetailKey(key); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (r *RemoveDetailsRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/details"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/details"
 }
 
 func (r *RemoveDetailsRequest) Method() string {
@@ -51,8 +58,8 @@ func (r *RemoveDetailsRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Keys != "" {
-		params["keys"] = r.Keys
+	if keys := r.keys(); keys != "" {
+		params["keys"] = keys
 	}
 
 	if r.Source != "" {
@@ -69,3 +76,16 @@ func (r *RemoveDetailsRequest) RequestParams() map[string]string {
 
 	return params
 }
+
+// keys joins Keys and KeyList into the comma separated keys parameter.
+func (r *RemoveDetailsRequest) keys() string {
+	keys := r.KeyList
+	if r.Keys != "" {
+		keys = append([]string{r.Keys}, keys...)
+	}
+	return strings.Join(keys, ",")
+}
+
+func (r *RemoveDetailsRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
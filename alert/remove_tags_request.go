@@ -2,6 +2,7 @@ package alert
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -10,27 +11,37 @@ import (
 type RemoveTagsRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string `validate:"required"`
 	Tags            string
-	Source          string
-	User            string
-	Note            string
+	// TagList holds the tags to remove as a list, an alternative to the comma separated Tags. Tags of the list
+	// cannot contain commas.
+	TagList []string
+	Source  string
+	User    string
+	Note    string
 }
 
 func (r *RemoveTagsRequest) Validate() error {
-	if r.Tags == "" {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
+	if r.Tags == "" && len(r.TagList) == 0 {
 		return errors.New("Tags can not be empty")
 	}
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	for _, tag := range r.TagList {
+		if tag == "" {
+			return errors.New("Tags can not contain an empty tag")
+		}
+		if strings.Contains(tag, ",") {
+			return errors.Errorf("Tag %s can not contain a comma", tag)
+		}
 	}
 	return nil
 }
 
 func (r *RemoveTagsRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/tags"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/tags"
 }
 
 func (r *RemoveTagsRequest) Method() string {
@@ -51,8 +62,8 @@ func (r *RemoveTagsRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Tags != "" {
-		params["tags"] = r.Tags
+	if tags := r.tags(); tags != "" {
+		params["tags"] = tags
 	}
 
 	if r.Source != "" {
@@ -69,3 +80,16 @@ func (r *RemoveTagsRequest) RequestParams() map[string]string {
 
 	return params
 }
+
+// tags joins Tags and TagList into the comma separated tags parameter.
+func (r *RemoveTagsRequest) tags() string {
+	tags := r.TagList
+	if r.Tags != "" {
+		tags = append([]string{r.Tags}, tags...)
+	}
+	return strings.Join(tags, ",")
+}
+
+func (r *RemoveTagsRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
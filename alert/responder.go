@@ -9,11 +9,13 @@ const (
 	ScheduleResponder   ResponderType = "schedule"
 )
 
+// Responder is a user, team, escalation or schedule, selected by Type. Users are identified by Id or Username,
+// the others by Id or Name.
 type Responder struct {
-	Type     ResponderType `json:"type, omitempty"`
+	Type     ResponderType `json:"type,omitempty" validate:"required,oneof=user team escalation schedule"`
 	Name     string        `json:"name,omitempty"`
 	Id       string        `json:"id,omitempty"`
-	Username string        `json:"username, omitempty"`
+	Username string        `json:"username,omitempty"`
 }
 
 type Team struct {
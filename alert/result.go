@@ -44,6 +44,7 @@ type ListAlertResult struct {
 
 type RequestStatusResult struct {
 	client.ResultMetadata
+	Success       bool      `json:"success,omitempty"`
 	IsSuccess     bool      `json:"isSuccess,omitempty"`
 	Action        string    `json:"action,omitempty"`
 	ProcessedAt   time.Time `json:"processedAt,omitempty"`
@@ -53,6 +54,24 @@ type RequestStatusResult struct {
 	Alias         string    `json:"alias,omitempty"`
 }
 
+// Succeeded reports whether OpsGenie processed the request successfully.
+func (r *RequestStatusResult) Succeeded() bool {
+	return r.IsSuccess
+}
+
+// EntityId returns the id of the alert the request created or acted on.
+func (r *RequestStatusResult) EntityId() string {
+	return r.AlertID
+}
+
+// FailureReason returns the reason reported by OpsGenie when the request could not be processed.
+func (r *RequestStatusResult) FailureReason() string {
+	if r.IsSuccess {
+		return ""
+	}
+	return r.Status
+}
+
 type SavedSearchResult struct {
 	client.ResultMetadata
 	Id   string `json:"id,omitempty"`
@@ -11,7 +11,7 @@ import (
 type SnoozeAlertRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
+	IdentifierValue string    `validate:"required"`
 	EndTime         time.Time `json:"endTime,omitempty"`
 	User            string    `json:"user,omitempty"`
 	Source          string    `json:"source,omitempty"`
@@ -19,10 +19,9 @@ type SnoozeAlertRequest struct {
 }
 
 func (r *SnoozeAlertRequest) Validate() error {
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-
 	if time.Now().After(r.EndTime) {
 		return errors.New("EndTime should at least be 2 seconds later.")
 	}
@@ -31,7 +30,7 @@ func (r *SnoozeAlertRequest) Validate() error {
 
 func (r *SnoozeAlertRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/snooze"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/snooze"
 
 }
 
@@ -55,3 +54,7 @@ func (r *SnoozeAlertRequest) RequestParams() map[string]string {
 	}
 	return params
 }
+
+func (r *SnoozeAlertRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, &r.Source)
+}
@@ -0,0 +1,118 @@
+package alert
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+)
+
+const suppressorSubsystem = "alert-suppressor"
+
+// Suppressor skips creating alerts whose alias it created within the window, to save rate limit during alert
+// storms. Unlike the deduplication of Opsgenie, suppressed alerts are not sent at all, so their count is not
+// increased. Alerts without an alias are keyed by their message. Every suppressed alert publishes a
+// client.SubsystemMetric.
+type Suppressor struct {
+	client *Client
+	window time.Duration
+
+	mux        sync.Mutex
+	created    map[string]*createdAlert
+	suppressed uint64
+}
+
+// createdAlert is an alert created by the suppressor, or being created while done is open. Its result is nil if the
+// creation failed.
+type createdAlert struct {
+	at     time.Time
+	done   chan struct{}
+	result *AsyncAlertResult
+}
+
+func NewSuppressor(client *Client, window time.Duration) *Suppressor {
+	return &Suppressor{
+		client:  client,
+		window:  window,
+		created: make(map[string]*createdAlert),
+	}
+}
+
+// Create creates the alert unless an alert with the same alias was created within the window. Suppressed alerts
+// return the result of the alert that suppressed them and true. Alerts with the alias of an alert that is still
+// being created wait for its creation, and are created themselves if it fails.
+func (s *Suppressor) Create(ctx context.Context, req *CreateAlertRequest) (*AsyncAlertResult, bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	key := req.Alias
+	if key == "" {
+		key = req.Message
+	}
+	clock := s.client.client.Clock()
+
+	for {
+		s.mux.Lock()
+		now := clock.Now()
+		for alias, created := range s.created {
+			if created.result != nil && now.Sub(created.at) >= s.window {
+				delete(s.created, alias)
+			}
+		}
+		created, ok := s.created[key]
+		if !ok {
+			created = &createdAlert{at: now, done: make(chan struct{})}
+			s.created[key] = created
+			s.mux.Unlock()
+			return s.create(ctx, req, key, created)
+		}
+		s.mux.Unlock()
+
+		select {
+		case <-created.done:
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		}
+		if created.result == nil {
+			continue
+		}
+		atomic.AddUint64(&s.suppressed, 1)
+		s.client.client.PublishSubsystemMetric(ctx, &client.SubsystemMetric{
+			Subsystem: suppressorSubsystem,
+			Name:      key,
+			Event:     client.SuppressEvent,
+		})
+		return created.result, true, nil
+	}
+}
+
+// create creates the alert reserved by created and releases the alerts waiting for it. The reservation is dropped
+// if the creation fails.
+func (s *Suppressor) create(ctx context.Context, req *CreateAlertRequest, key string, created *createdAlert) (*AsyncAlertResult, bool, error) {
+	result, err := s.client.Create(ctx, req)
+	s.mux.Lock()
+	if err != nil && s.created[key] == created {
+		delete(s.created, key)
+	}
+	created.result = result
+	close(created.done)
+	s.mux.Unlock()
+	if err != nil {
+		return nil, false, err
+	}
+	return result, false, nil
+}
+
+// Forget makes the next alert with the alias be created, e.g. after the alert was closed.
+func (s *Suppressor) Forget(alias string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.created, alias)
+}
+
+// Suppressed returns the number of alerts suppressed so far.
+func (s *Suppressor) Suppressed() uint64 {
+	return atomic.LoadUint64(&s.suppressed)
+}
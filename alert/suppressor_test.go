@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressor(t *testing.T) {
+	var requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"result": "Request will be processed", "took": 0.1, "requestId": "r%d"}`, n)
+	}))
+	defer ts.Close()
+
+	clock := client.NewManualClock(time.Date(2019, 12, 24, 10, 0, 0, 0, time.UTC))
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		Clock:          clock,
+	})
+	assert.Nil(t, err)
+
+	suppressor := NewSuppressor(alertClient, time.Minute)
+	result, suppressed, err := suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "db-disk"})
+	assert.Nil(t, err)
+	assert.False(t, suppressed)
+	assert.Equal(t, "r1", result.RequestId)
+
+	clock.Advance(30 * time.Second)
+	result, suppressed, err = suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "db-disk"})
+	assert.Nil(t, err)
+	assert.True(t, suppressed)
+	assert.Equal(t, "r1", result.RequestId)
+	_, suppressed, _ = suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "cache-disk"})
+	assert.False(t, suppressed)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	assert.Equal(t, uint64(1), suppressor.Suppressed())
+
+	clock.Advance(30 * time.Second)
+	result, suppressed, err = suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "db-disk"})
+	assert.Nil(t, err)
+	assert.False(t, suppressed)
+	assert.Equal(t, "r3", result.RequestId)
+
+	suppressor.Forget("db-disk")
+	_, suppressed, _ = suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "db-disk"})
+	assert.False(t, suppressed)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&requests))
+}
+
+func TestSuppressorCreatesConcurrentAlertsOnce(t *testing.T) {
+	requests, results := createConcurrently(t, false)
+	assert.Equal(t, int32(1), requests)
+	assert.Equal(t, map[string]int{"created": 1, "suppressed": 9}, results)
+
+	// A failed creation drops its reservation, so one of the waiting alerts is created instead.
+	requests, results = createConcurrently(t, true)
+	assert.Equal(t, int32(2), requests)
+	assert.Equal(t, map[string]int{"failed": 1, "created": 1, "suppressed": 8}, results)
+}
+
+// createConcurrently creates ten alerts with the same alias while the first creation is in flight and returns the
+// number of API calls and of failed, created and suppressed alerts.
+func createConcurrently(t *testing.T, failFirst bool) (int32, map[string]int) {
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if n == 1 {
+			<-release
+			if failFirst {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				fmt.Fprint(w, `{"message": "invalid alert", "took": 0.1, "requestId": "r1"}`)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"result": "Request will be processed", "took": 0.1, "requestId": "r%d"}`, n)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+	suppressor := NewSuppressor(alertClient, time.Minute)
+
+	var wg sync.WaitGroup
+	var mux sync.Mutex
+	results := make(map[string]int)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, suppressed, err := suppressor.Create(context.Background(), &CreateAlertRequest{Message: "disk full", Alias: "db-disk"})
+			mux.Lock()
+			defer mux.Unlock()
+			switch {
+			case err != nil:
+				results["failed"]++
+			case suppressed:
+				results["suppressed"]++
+			default:
+				results["created"]++
+			}
+		}()
+	}
+	for atomic.LoadInt32(&requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, uint64(results["suppressed"]), suppressor.Suppressed())
+	return atomic.LoadInt32(&requests), results
+}
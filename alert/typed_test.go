@@ -0,0 +1,34 @@
+//go:build go1.21
+// +build go1.21
+
+package alert
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecTyped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "rId")
+		fmt.Fprint(w, `{"data": {"id": "a1", "message": "disk full"}, "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	result, err := ExecTyped[GetAlertResult](nil, alertClient, &GetAlertRequest{IdentifierType: ALERTID, IdentifierValue: "a1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "disk full", result.Message)
+	assert.Equal(t, "rId", result.RequestId)
+}
@@ -4,29 +4,22 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type UpdateDescriptionRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Description     string `json:"description,omitempty"`
+	IdentifierValue string `validate:"required"`
+	Description     string `json:"description,omitempty" validate:"required"`
 }
 
 func (r *UpdateDescriptionRequest) Validate() error {
-	if r.Description == "" {
-		return errors.New("Description can not be empty")
-	}
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdateDescriptionRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/description"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/description"
 }
 
 func (r *UpdateDescriptionRequest) Method() string {
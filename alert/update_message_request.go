@@ -4,29 +4,22 @@ import (
 	"net/http"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
-	"github.com/pkg/errors"
 )
 
 type UpdateMessageRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Message         string `json:"message,omitempty"`
+	IdentifierValue string `validate:"required"`
+	Message         string `json:"message,omitempty" validate:"required"`
 }
 
 func (r *UpdateMessageRequest) Validate() error {
-	if r.Message == "" {
-		return errors.New("Message can not be empty")
-	}
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdateMessageRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/message"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/message"
 }
 
 func (r *UpdateMessageRequest) Method() string {
@@ -10,27 +10,17 @@ import (
 type UpdatePriorityRequest struct {
 	client.BaseRequest
 	IdentifierType  AlertIdentifier
-	IdentifierValue string
-	Priority        Priority `json:"priority,omitempty"`
+	IdentifierValue string   `validate:"required"`
+	Priority        Priority `json:"priority,omitempty" validate:"required,oneof=P1 P2 P3 P4 P5"`
 }
 
 func (r *UpdatePriorityRequest) Validate() error {
-	if r.Priority == "" {
-		return errors.New("Priority can not be empty")
-	}
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-	err := ValidatePriority(r.Priority)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdatePriorityRequest) ResourcePath() string {
 
-	return "/v2/alerts/" + r.IdentifierValue + "/priority"
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/priority"
 
 }
 
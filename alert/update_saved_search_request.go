@@ -10,38 +10,27 @@ import (
 type UpdateSavedSearchRequest struct {
 	client.BaseRequest
 	IdentifierType  SearchIdentifierType
-	IdentifierValue string
-	NewName         string `json:"name,omitempty"`
-	Query           string `json:"query,omitempty"`
+	IdentifierValue string `validate:"required"`
+	NewName         string `json:"name,omitempty" validate:"required"`
+	Query           string `json:"query,omitempty" validate:"required"`
 	Owner           User   `json:"owner,omitempty"`
 	Description     string `json:"description,omitempty"`
 	Teams           []Team `json:"teams,omitempty"`
 }
 
 func (r *UpdateSavedSearchRequest) Validate() error {
-
-	if r.IdentifierValue == "" {
-		return errors.New("Identifier can not be empty")
-	}
-
-	if r.NewName == "" {
-		return errors.New("Name can not be empty")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-
-	if r.Query == "" {
-		return errors.New("Query can not be empty")
-	}
-
 	if r.Owner.ID == "" && r.Owner.Username == "" {
 		return errors.New("Owner can not be empty")
 	}
-
 	return nil
 }
 
 func (r *UpdateSavedSearchRequest) ResourcePath() string {
 
-	return "/v2/alerts/saved-searches/" + r.IdentifierValue
+	return "/v2/alerts/saved-searches/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *UpdateSavedSearchRequest) Method() string {
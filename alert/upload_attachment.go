@@ -0,0 +1,140 @@
+package alert
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+)
+
+// MaxAttachmentSize is the largest attachment OpsGenie accepts.
+const MaxAttachmentSize = 25 * 1024 * 1024
+
+// UploadAlertAttachmentRequest uploads an attachment read from Content. The content is streamed and its content
+// type is detected from the file name or sniffed from the content. Retries are only possible when Content is an
+// io.Seeker; they read the content again from the offset it had when it was first read.
+type UploadAlertAttachmentRequest struct {
+	client.BaseRequest
+	IdentifierType  AlertIdentifier
+	IdentifierValue string    `validate:"required"`
+	FileName        string    `validate:"required"`
+	Content         io.Reader `validate:"required"`
+	User            string
+	IndexFile       string
+
+	read bool
+	// start is the offset of seekable content when it was first read.
+	start int64
+}
+
+func (r *UploadAlertAttachmentRequest) File() (string, io.ReadCloser, error) {
+	seeker, seekable := r.Content.(io.Seeker)
+	if r.read {
+		if !seekable {
+			return "", nil, errors.New("Attachment content cannot be read again for a retry.")
+		}
+		if _, err := seeker.Seek(r.start, io.SeekStart); err != nil {
+			return "", nil, err
+		}
+	} else if seekable {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return "", nil, err
+		}
+		r.start = start
+	}
+	r.read = true
+	return r.FileName, ioutil.NopCloser(&sizeLimitedReader{reader: r.Content, remaining: MaxAttachmentSize}), nil
+}
+
+func (r *UploadAlertAttachmentRequest) FormFields() map[string]string {
+	fields := make(map[string]string)
+	if r.User != "" {
+		fields["user"] = r.User
+	}
+	if r.IndexFile != "" {
+		fields["indexFile"] = r.IndexFile
+	}
+	return fields
+}
+
+func (r *UploadAlertAttachmentRequest) Validate() error {
+	if err := client.ValidateStruct(r); err != nil {
+		return err
+	}
+	if size, ok := contentSize(r.Content); ok && size > MaxAttachmentSize {
+		return errors.Errorf("Attachment cannot be larger than %d bytes.", MaxAttachmentSize)
+	}
+	return nil
+}
+
+func (r *UploadAlertAttachmentRequest) ResourcePath() string {
+	return "/v2/alerts/" + client.PathSegment(r.IdentifierValue) + "/attachments"
+}
+
+func (r *UploadAlertAttachmentRequest) Method() string {
+	return http.MethodPost
+}
+
+func (r *UploadAlertAttachmentRequest) RequestParams() map[string]string {
+	params := make(map[string]string)
+	if r.IdentifierType == ALIAS {
+		params["alertIdentifierType"] = "alias"
+	} else if r.IdentifierType == TINYID {
+		params["alertIdentifierType"] = "tiny"
+	} else {
+		params["alertIdentifierType"] = "id"
+	}
+	return params
+}
+
+func (r *UploadAlertAttachmentRequest) ApplyActor(actor client.Actor) {
+	actor.Apply(&r.User, nil)
+}
+
+func (c *Client) UploadAlertAttachment(ctx context.Context, req *UploadAlertAttachmentRequest) (*CreateAlertAttachmentsResult, error) {
+	result := &CreateAlertAttachmentsResult{}
+	err := c.client.Exec(ctx, req, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// contentSize returns the remaining size of readers that know it.
+func contentSize(content io.Reader) (int64, bool) {
+	switch reader := content.(type) {
+	case interface{ Len() int }:
+		return int64(reader.Len()), true
+	case *os.File:
+		info, err := reader.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return 0, false
+		}
+		offset, err := reader.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		return info.Size() - offset, true
+	}
+	return 0, false
+}
+
+// sizeLimitedReader fails once more than remaining bytes are read, so oversized uploads are aborted.
+type sizeLimitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (r *sizeLimitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, errors.Errorf("Attachment cannot be larger than %d bytes.", MaxAttachmentSize)
+	}
+	return n, err
+}
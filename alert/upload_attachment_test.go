@@ -0,0 +1,80 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadAlertAttachment(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/alerts/my-alias/attachments", r.URL.Path)
+		assert.Equal(t, "alias", r.URL.Query().Get("alertIdentifierType"))
+		file, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "report.json", header.Filename)
+		assert.Equal(t, "application/json", header.Header.Get("Content-Type"))
+		assert.Equal(t, `{"status": "down"}`, string(content))
+		assert.Equal(t, "john@example.com", r.FormValue("user"))
+		fmt.Fprint(w, `{"result": "Created", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = alertClient.UploadAlertAttachment(context.Background(), &UploadAlertAttachmentRequest{
+		IdentifierType:  ALIAS,
+		IdentifierValue: "my-alias",
+		FileName:        "report.json",
+		Content:         strings.NewReader(`{"status": "down"}`),
+		User:            "john@example.com",
+	})
+	assert.Nil(t, err)
+
+	_, err = alertClient.UploadAlertAttachment(context.Background(), &UploadAlertAttachmentRequest{
+		IdentifierValue: "my-alias",
+		FileName:        "dump.bin",
+		Content:         bytes.NewReader(make([]byte, MaxAttachmentSize+1)),
+	})
+	assert.EqualError(t, err, "Attachment cannot be larger than 26214400 bytes.")
+}
+
+func TestSizeLimitedReader(t *testing.T) {
+	reader := &sizeLimitedReader{reader: strings.NewReader("12345"), remaining: 4}
+	_, err := ioutil.ReadAll(reader)
+	assert.EqualError(t, err, "Attachment cannot be larger than 26214400 bytes.")
+
+	reader = &sizeLimitedReader{reader: strings.NewReader("1234"), remaining: 4}
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "1234", string(content))
+}
+
+func TestUploadAlertAttachmentRetryReadsFromStart(t *testing.T) {
+	content := strings.NewReader("header;report")
+	_, err := content.Seek(int64(len("header;")), io.SeekStart)
+	assert.Nil(t, err)
+	request := &UploadAlertAttachmentRequest{IdentifierValue: "my-alias", FileName: "report.txt", Content: content}
+
+	for i := 0; i < 2; i++ {
+		_, file, err := request.File()
+		assert.Nil(t, err)
+		read, err := ioutil.ReadAll(file)
+		assert.Nil(t, err)
+		assert.Equal(t, "report", string(read))
+	}
+}
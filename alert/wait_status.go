@@ -0,0 +1,97 @@
+package alert
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultStatusWaitIntervalMin = 500 * time.Millisecond
+	defaultStatusWaitIntervalMax = 5 * time.Second
+)
+
+type AlertStatus string
+
+const (
+	OpenStatus         AlertStatus = "open"
+	AcknowledgedStatus AlertStatus = "acknowledged"
+	SnoozedStatus      AlertStatus = "snoozed"
+	ClosedStatus       AlertStatus = "closed"
+)
+
+// ReachedStatus reports whether the alert is in the status or past it. Acknowledged and snoozed alerts are passed
+// by closing them, every alert has passed the open status.
+func (r *GetAlertResult) ReachedStatus(status AlertStatus) bool {
+	closed := r.Status == string(ClosedStatus)
+	switch status {
+	case OpenStatus:
+		return true
+	case AcknowledgedStatus:
+		return r.Acknowledged || closed
+	case SnoozedStatus:
+		return r.Snoozed || closed
+	default:
+		return closed
+	}
+}
+
+// WaitForAlertStatus polls the alert until it reaches or passes the status and returns its last state. Alerts that
+// do not exist yet, e.g. because their creation is still processed, are polled as well. Polling stops when the
+// context is done or MaxWait of the options elapses.
+func (c *Client) WaitForAlertStatus(ctx context.Context, req *GetAlertRequest, status AlertStatus, options client.WaitOptions) (*GetAlertResult, error) {
+	ctx, err := c.client.DefaultContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if options.MaxWait <= 0 {
+		options.MaxWait = client.DefaultMaxWait
+	}
+	if options.IntervalMin <= 0 {
+		options.IntervalMin = defaultStatusWaitIntervalMin
+	}
+	if options.IntervalMax < options.IntervalMin {
+		options.IntervalMax = defaultStatusWaitIntervalMax
+		if options.IntervalMax < options.IntervalMin {
+			options.IntervalMax = options.IntervalMin
+		}
+	}
+	clock := c.client.Clock()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	expired := make(chan struct{})
+	stop := clock.AfterFunc(options.MaxWait, func() {
+		close(expired)
+		cancel()
+	})
+	defer stop()
+
+	for i := 0; ; i++ {
+		result, err := c.Get(ctx, req)
+		if err == nil && result.ReachedStatus(status) {
+			return result, nil
+		}
+		if apiErr, ok := err.(*client.ApiError); err != nil && (!ok || apiErr.StatusCode != http.StatusNotFound) {
+			if ctx.Err() == nil {
+				return nil, err
+			}
+		}
+
+		wait := retryablehttp.DefaultBackoff(options.IntervalMin, options.IntervalMax, i, nil)
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			select {
+			case <-expired:
+				err = context.DeadlineExceeded
+			default:
+			}
+			return nil, errors.Wrapf(err, "Alert %s did not reach status %s in time", req.IdentifierValue, status)
+		case <-clock.After(wait):
+		}
+	}
+}
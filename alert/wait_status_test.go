@@ -0,0 +1,91 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForAlertStatus(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case polls == 1:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Alert does not exist", "took": 0.01, "requestId": "rId"}`)
+		case polls == 2:
+			fmt.Fprint(w, `{"data": {"id": "a1", "status": "open", "acknowledged": false}, "took": 0.01, "requestId": "rId"}`)
+		default:
+			fmt.Fprint(w, `{"data": {"id": "a1", "status": "closed", "acknowledged": false}, "took": 0.01, "requestId": "rId"}`)
+		}
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+	options := client.WaitOptions{IntervalMin: time.Millisecond, IntervalMax: time.Millisecond}
+
+	result, err := alertClient.WaitForAlertStatus(context.Background(), &GetAlertRequest{IdentifierValue: "a1"}, AcknowledgedStatus, options)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, "closed", result.Status)
+
+	options.MaxWait = 20 * time.Millisecond
+	_, err = alertClient.WaitForAlertStatus(context.Background(), &GetAlertRequest{IdentifierValue: "a1"}, SnoozedStatus, options)
+	assert.Nil(t, err)
+}
+
+func TestWaitForAlertStatusNilContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": "a1", "status": "closed", "acknowledged": false}, "took": 0.01, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	alertClient, err := NewClient(config)
+	assert.Nil(t, err)
+
+	result, err := alertClient.WaitForAlertStatus(nil, &GetAlertRequest{IdentifierValue: "a1"}, ClosedStatus, client.WaitOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "closed", result.Status)
+
+	config.Compatibility = &client.Compatibility{}
+	_, err = alertClient.WaitForAlertStatus(nil, &GetAlertRequest{IdentifierValue: "a1"}, ClosedStatus, client.WaitOptions{})
+	assert.EqualError(t, err, "Context cannot be nil.")
+}
+
+func TestWaitForAlertStatusTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": "a1", "status": "open"}, "took": 0.01, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	alertClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = alertClient.WaitForAlertStatus(context.Background(), &GetAlertRequest{IdentifierValue: "a1"}, ClosedStatus,
+		client.WaitOptions{MaxWait: 20 * time.Millisecond, IntervalMin: time.Millisecond, IntervalMax: time.Millisecond})
+	assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	assert.Equal(t, "Alert a1 did not reach status closed in time: context deadline exceeded", err.Error())
+}
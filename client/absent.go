@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// RemovalRequest is implemented by requests that remove or close a resource without using the DELETE method, so a
+// 404 response to them can be treated as success. See Config.IgnoreNotFoundOnRemoval.
+type RemovalRequest interface {
+	IsRemoval() bool
+}
+
+type ignoreNotFoundContextKey struct{}
+
+// WithIgnoreNotFoundOnRemoval returns a context that overrides Config.IgnoreNotFoundOnRemoval for the requests
+// executed with it.
+func WithIgnoreNotFoundOnRemoval(ctx context.Context, ignore bool) context.Context {
+	return context.WithValue(ctx, ignoreNotFoundContextKey{}, ignore)
+}
+
+// alreadyAbsent reports whether the error is a 404 response to a removal that should be treated as success.
+func (cli *OpsGenieClient) alreadyAbsent(ctx context.Context, request ApiRequest, err error) bool {
+	apiErr, ok := err.(*ApiError)
+	if !ok || apiErr.StatusCode != http.StatusNotFound {
+		return false
+	}
+	ignore, ok := ctx.Value(ignoreNotFoundContextKey{}).(bool)
+	if !ok {
+		ignore = cli.Config.IgnoreNotFoundOnRemoval
+	}
+	if !ignore {
+		return false
+	}
+	if removal, ok := request.(RemovalRequest); ok {
+		return removal.IsRemoval()
+	}
+	return request.Method() == http.MethodDelete
+}
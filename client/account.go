@@ -0,0 +1,19 @@
+package client
+
+import "context"
+
+type accountContextKey struct{}
+
+// AccountFromContext returns the account of the client that published a metric with the context. It is only set
+// for clients with Config.Account, so ContextProcess subscribers can tell apart clients of different accounts.
+func AccountFromContext(ctx context.Context) (string, bool) {
+	account, ok := ctx.Value(accountContextKey{}).(string)
+	return account, ok
+}
+
+func (cli *OpsGenieClient) withAccount(ctx context.Context) context.Context {
+	if cli.Config.Account == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, accountContextKey{}, cli.Config.Account)
+}
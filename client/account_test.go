@@ -0,0 +1,19 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAccount(t *testing.T) {
+	cli := &OpsGenieClient{Config: &Config{}}
+	_, ok := AccountFromContext(cli.withAccount(context.Background()))
+	assert.False(t, ok)
+
+	cli.Config.Account = "prod"
+	account, ok := AccountFromContext(cli.withAccount(context.Background()))
+	assert.True(t, ok)
+	assert.Equal(t, "prod", account)
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"reflect"
+)
+
+// Actor is the user and source an action is performed with. OpsGenie shows them in the activity log of the entity.
+type Actor struct {
+	User   string
+	Source string
+}
+
+// ActorRequest is implemented by requests that accept a user and source. Exec fills the ones left empty from the
+// actor of the context, falling back to Config.Actor, on a copy of the request it sends.
+type ActorRequest interface {
+	ApplyActor(actor Actor)
+}
+
+type actorContextKey struct{}
+
+// WithActor returns a context that makes actions executed with it performed by the given actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
+
+// Apply sets user and source to the ones of the actor unless they are already set. Nil pointers are skipped, so
+// requests that only accept one of them can pass nil for the other.
+func (a Actor) Apply(user *string, source *string) {
+	if user != nil && *user == "" {
+		*user = a.User
+	}
+	if source != nil && *source == "" {
+		*source = a.Source
+	}
+}
+
+// applyActor returns the request with the actor applied. The actor is applied to a copy of the request, so the
+// request of the caller is left as it is and can be reused or shared.
+func (cli *OpsGenieClient) applyActor(ctx context.Context, apiRequest ApiRequest) ApiRequest {
+	if _, ok := apiRequest.(ActorRequest); !ok {
+		return apiRequest
+	}
+	actor := cli.Config.Actor
+	if contextActor, ok := ActorFromContext(ctx); ok {
+		if contextActor.User != "" {
+			actor.User = contextActor.User
+		}
+		if contextActor.Source != "" {
+			actor.Source = contextActor.Source
+		}
+	}
+	if actor.User == "" && actor.Source == "" {
+		return apiRequest
+	}
+	value := reflect.ValueOf(apiRequest)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return apiRequest
+	}
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	copiedRequest, ok := copied.Interface().(ApiRequest)
+	if !ok {
+		return apiRequest
+	}
+	copiedRequest.(ActorRequest).ApplyActor(actor)
+	return copiedRequest
+}
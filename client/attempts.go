@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultAttemptSummaries = 5
+	attemptExcerptSize      = 256
+)
+
+// AttemptSummary describes a single attempt of a request. Summaries of the last attempts are attached to the
+// errors returned when retries are exhausted, to tell consistent failures from flapping ones.
+type AttemptSummary struct {
+	Attempt    int
+	StatusCode int
+	Duration   time.Duration
+	// Excerpt is the beginning of the response body.
+	Excerpt string
+	Error   string
+}
+
+func (s AttemptSummary) String() string {
+	if s.Error != "" {
+		return fmt.Sprintf("attempt %d failed after %s: %s", s.Attempt, s.Duration, s.Error)
+	}
+	return fmt.Sprintf("attempt %d got status %d after %s: %s", s.Attempt, s.StatusCode, s.Duration, s.Excerpt)
+}
+
+// RetriesExhaustedError is returned when the last attempt of a request failed without a response.
+type RetriesExhaustedError struct {
+	Err      error
+	Attempts []AttemptSummary
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetriesExhaustedError) Cause() error {
+	return e.Err
+}
+
+// RetryCancelledError is returned when the context of a request is done while waiting to retry it.
+type RetryCancelledError struct {
+	Err      error
+	Attempts []AttemptSummary
+}
+
+func (e *RetryCancelledError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryCancelledError) Cause() error {
+	return e.Err
+}
+
+// attemptLog keeps the summaries of the last attempts of a request.
+type attemptLog struct {
+	max       int
+	summaries []AttemptSummary
+}
+
+func (cli *OpsGenieClient) newAttemptLog() *attemptLog {
+	max := cli.Config.AttemptSummaries
+	if max <= 0 {
+		max = defaultAttemptSummaries
+	}
+	return &attemptLog{max: max}
+}
+
+func (l *attemptLog) add(attempt int, duration time.Duration, response *http.Response, err error, excerpt string) {
+	summary := AttemptSummary{Attempt: attempt, Duration: duration, Excerpt: excerpt}
+	if err != nil {
+		summary.Error = err.Error()
+	}
+	if response != nil {
+		summary.StatusCode = response.StatusCode
+	}
+	if len(l.summaries) == l.max {
+		l.summaries = l.summaries[1:]
+	}
+	l.summaries = append(l.summaries, summary)
+}
+
+// drainBody reads a bounded part of a body that will not be used so the connection can be reused, and returns
+// its beginning.
+func drainBody(body io.ReadCloser) string {
+	defer body.Close()
+	excerpt := &bytes.Buffer{}
+	io.Copy(excerpt, io.LimitReader(body, attemptExcerptSize))
+	io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return excerpt.String()
+}
+
+// peekBody returns the beginning of the response body without consuming it.
+func peekBody(response *http.Response) string {
+	excerpt := make([]byte, attemptExcerptSize)
+	n, _ := io.ReadFull(response.Body, excerpt)
+	excerpt = excerpt[:n]
+	response.Body = teeBody{io.MultiReader(bytes.NewReader(excerpt), response.Body), response.Body}
+	return string(excerpt)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// AuditWarning is a setting of the effective configuration that is risky in production.
+type AuditWarning struct {
+	// Setting names the setting, e.g. "RequestTimeout" or "EndpointProfiles[/v2/alerts*].DisableRetries".
+	Setting string
+	Message string
+}
+
+// Audit inspects the effective configuration of the client for settings that go unnoticed until an incident:
+// requests without any timeout, retries that are disabled, debug logging that writes request details to the logs,
+// TLS verification that is off and dry runs that send nothing.
+//
+// Read-write API keys used by read-only tools are not reported. The API doesn't expose the rights of a key, and
+// telling them apart would take a write request, which an audit must not send. Restrict such keys to read access in
+// the API key settings of Opsgenie instead.
+func (cli *OpsGenieClient) Audit() []AuditWarning {
+	var warnings []AuditWarning
+	warn := func(setting string, message string) {
+		warnings = append(warnings, AuditWarning{Setting: setting, Message: message})
+	}
+
+	if cli.RetryableClient.HTTPClient.Timeout == 0 && cli.Config.MaxElapsedTime == 0 {
+		warn("RequestTimeout", "No request timeout or max elapsed time is set, requests to an unresponsive API can hang forever.")
+	}
+	if cli.RetryableClient.RetryMax <= 0 {
+		warn("RetryCount", "Retries are disabled, throttled requests and server errors fail at once.")
+	}
+	for _, profile := range cli.Config.EndpointProfiles {
+		if profile.DisableRetries {
+			warn("EndpointProfiles["+profile.Pattern+"].DisableRetries", "Retries are disabled for the endpoints of the profile.")
+		}
+	}
+	if cli.Config.DebugHttp {
+		warn("DebugHttp", "Requests and responses are logged with their bodies, which can contain personal data.")
+	} else if cli.Config.Logger != nil && cli.Config.Logger.IsLevelEnabled(logrus.DebugLevel) {
+		warn("LogLevel", "Debug logging is enabled, requests are logged with their contents.")
+	}
+	if tls := cli.Config.TLSConfiguration; tls != nil && tls.Config != nil && tls.Config.InsecureSkipVerify {
+		warn("TLSConfiguration", "TLS certificates of the API are not verified.")
+	}
+	if cli.Config.DryRun {
+		warn("DryRun", "Dry run is enabled, no request is sent to Opsgenie.")
+	}
+	return warnings
+}
+
+func (cli *OpsGenieClient) logAudit() {
+	for _, warning := range cli.Audit() {
+		cli.Config.Logger.WithFields(logrus.Fields{
+			"setting": warning.Setting,
+			"account": cli.Config.Account,
+		}).Warn(warning.Message)
+	}
+}
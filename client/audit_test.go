@@ -0,0 +1,41 @@
+package client
+
+import (
+	"bytes"
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudit(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		RequestTimeout: 30 * time.Second,
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, ogClient.Audit())
+
+	logs := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetOutput(logs)
+	logger.SetLevel(logrus.DebugLevel)
+	ogClient, err = NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		EndpointProfiles: []EndpointProfile{{Pattern: "/v2/heartbeats*", DisableRetries: true}},
+		TLSConfiguration: &TLSConfiguration{Config: &tls.Config{InsecureSkipVerify: true}},
+		AuditOnStartup:   true,
+		Logger:           logger,
+	})
+	assert.Nil(t, err)
+
+	var settings []string
+	for _, warning := range ogClient.Audit() {
+		settings = append(settings, warning.Setting)
+	}
+	assert.Equal(t, []string{"RequestTimeout", "EndpointProfiles[/v2/heartbeats*].DisableRetries", "LogLevel", "TLSConfiguration"}, settings)
+	assert.Contains(t, logs.String(), "setting=RequestTimeout")
+	assert.Contains(t, logs.String(), "setting=TLSConfiguration")
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// JitterStrategy randomizes the exponential backoff between retries, so clients failing at the same time do not
+// retry at the same time.
+type JitterStrategy int
+
+const (
+	// NoJitter waits exactly the exponential backoff.
+	NoJitter JitterStrategy = iota
+	// FullJitter waits a random duration between zero and the exponential backoff.
+	FullJitter
+	// EqualJitter waits half of the exponential backoff plus a random duration up to the other half.
+	EqualJitter
+)
+
+func jitterBackoff(strategy JitterStrategy) retryablehttp.Backoff {
+	return func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		wait := retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+		if wait <= 0 {
+			return wait
+		}
+		switch strategy {
+		case FullJitter:
+			return time.Duration(rand.Int63n(int64(wait) + 1))
+		case EqualJitter:
+			half := wait / 2
+			return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+		default:
+			return wait
+		}
+	}
+}
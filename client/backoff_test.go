@@ -0,0 +1,70 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJitterBackoff(t *testing.T) {
+	min, max := time.Second, 30*time.Second
+	assert.Equal(t, 4*time.Second, jitterBackoff(NoJitter)(min, max, 2, nil))
+	for i := 0; i < 100; i++ {
+		full := jitterBackoff(FullJitter)(min, max, 2, nil)
+		assert.True(t, full >= 0 && full <= 4*time.Second)
+		equal := jitterBackoff(EqualJitter)(min, max, 2, nil)
+		assert.True(t, equal >= 2*time.Second && equal <= 4*time.Second)
+	}
+	assert.True(t, jitterBackoff(FullJitter)(min, max, 10, nil) <= max)
+}
+
+func TestRetryWaitConfig(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{ApiKey: "apiKey", RetryWaitMin: time.Millisecond, RetryWaitMax: time.Second})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Millisecond, ogClient.RetryableClient.RetryWaitMin)
+	assert.Equal(t, time.Second, ogClient.RetryableClient.RetryWaitMax)
+
+	_, err = NewOpsGenieClient(&Config{ApiKey: "apiKey", RetryWaitMin: time.Second, RetryWaitMax: time.Millisecond})
+	assert.Equal(t, "Retry wait min cannot be greater than retry wait max.", err.Error())
+	_, err = NewOpsGenieClient(&Config{ApiKey: "apiKey", MaxElapsedTime: -time.Second})
+	assert.Equal(t, "Retry wait times and max elapsed time cannot be negative.", err.Error())
+}
+
+func TestMaxElapsedTime(t *testing.T) {
+	attemptCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	clock := NewManualClock(time.Now())
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		Clock:          clock,
+		MaxElapsedTime: 90 * time.Minute,
+		Backoff: func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return time.Hour
+		},
+	})
+	assert.Nil(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	}()
+	// One waiter is the MaxElapsedTime timer, the other the backoff of the first retry.
+	for clock.Waiters() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(time.Hour)
+	err = <-done
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, attemptCount)
+	assert.Equal(t, 0, clock.Waiters())
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultBatchConcurrency = 4
+
+// RateLimiter spaces out requests to at most the given number per second. It can be shared by batch executors so
+// they stay below a rate limit together.
+type RateLimiter struct {
+	interval time.Duration
+	clock    Clock
+
+	mux  sync.Mutex
+	next time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond requests per second. A nil clock uses SystemClock.
+func NewRateLimiter(requestsPerSecond float64, clock Clock) *RateLimiter {
+	if clock == nil {
+		clock = SystemClock
+	}
+	limiter := &RateLimiter{clock: clock}
+	if requestsPerSecond > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return limiter
+}
+
+// Wait blocks until the next request may be sent or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.interval <= 0 {
+		return ctx.Err()
+	}
+	l.mux.Lock()
+	now := l.clock.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mux.Unlock()
+
+	if wait := slot.Sub(now); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-l.clock.After(wait):
+		}
+	}
+	return ctx.Err()
+}
+
+// BatchItem is a request of a batch and the result it is parsed into.
+type BatchItem struct {
+	Request ApiRequest
+	Result  ApiResult
+}
+
+// BatchResult is the outcome of a BatchItem.
+type BatchResult struct {
+	Request ApiRequest
+	Result  ApiResult
+	Err     error
+}
+
+// BatchExecutor executes many requests with a bounded number of concurrent calls, optionally sharing a rate limiter.
+type BatchExecutor struct {
+	Client *OpsGenieClient
+	// Concurrency is the maximum number of requests executed at once. Defaults to 4.
+	Concurrency int
+	RateLimiter *RateLimiter
+}
+
+func NewBatchExecutor(client *OpsGenieClient, concurrency int, rateLimiter *RateLimiter) *BatchExecutor {
+	return &BatchExecutor{Client: client, Concurrency: concurrency, RateLimiter: rateLimiter}
+}
+
+// Execute executes the items and returns their results in the order of the items. Items that were not started
+// before the context was done fail with the context's error.
+func (b *BatchExecutor) Execute(ctx context.Context, items []BatchItem) []BatchResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	results := make([]BatchResult, len(items))
+	started := make([]bool, len(items))
+	group, _ := NewGroup(ctx, b.Concurrency, b.RateLimiter)
+	for index, item := range items {
+		index, item := index, item
+		results[index] = BatchResult{Request: item.Request, Result: item.Result}
+		group.Go(func(ctx context.Context) error {
+			started[index] = true
+			// Failed items don't stop the batch, so the error is kept with the item instead of returned.
+			results[index].Err = b.Client.Exec(ctx, item.Request, item.Result)
+			return nil
+		})
+	}
+	group.Wait()
+	for index := range items {
+		if !started[index] {
+			results[index].Err = ctx.Err()
+		}
+	}
+	return results
+}
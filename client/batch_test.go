@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testBatchRequest struct {
+	testGetRequest
+}
+
+func (r *testBatchRequest) RequestParams() map[string]string {
+	return map[string]string{"field": r.MandatoryField}
+}
+
+func TestBatchExecutor(t *testing.T) {
+	defer withoutMetricSubscribers()()
+
+	var running, maxRunning int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		field := r.URL.Query().Get("field")
+		if field == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "bad field", "took": 1, "requestId": "rId"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"Data": "%s", "took": 1, "requestId": "rId"}`, field)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	items := make([]BatchItem, 10)
+	for i := range items {
+		field := fmt.Sprintf("item-%d", i)
+		if i == 3 {
+			field = "bad"
+		}
+		items[i] = BatchItem{Request: &testBatchRequest{testGetRequest{testRequest{MandatoryField: field}}}, Result: &testResult{}}
+	}
+
+	results := NewBatchExecutor(ogClient, 3, nil).Execute(context.Background(), items)
+	assert.Equal(t, 10, len(results))
+	for i, result := range results {
+		assert.Equal(t, items[i].Request, result.Request)
+		if i == 3 {
+			apiErr, ok := result.Err.(*ApiError)
+			assert.True(t, ok)
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+			continue
+		}
+		assert.Nil(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("item-%d", i), result.Result.(*testResult).Data)
+	}
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= 3)
+}
+
+func TestBatchExecutorWithCancelledContext(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{ApiKey: "apiKey"})
+	assert.Nil(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := NewBatchExecutor(ogClient, 0, NewRateLimiter(10, nil)).Execute(ctx, []BatchItem{
+		{Request: &testGetRequest{testRequest{MandatoryField: "afield"}}, Result: &testResult{}},
+	})
+	assert.Equal(t, context.Canceled, results[0].Err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	limiter := NewRateLimiter(2, clock)
+
+	assert.Nil(t, limiter.Wait(context.Background()))
+	done := make(chan error)
+	go func() {
+		done <- limiter.Wait(context.Background())
+	}()
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(400 * time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("rate limiter did not wait for its interval")
+	case <-time.After(10 * time.Millisecond):
+	}
+	clock.Advance(100 * time.Millisecond)
+	assert.Nil(t, <-done)
+}
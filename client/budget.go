@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+type retryBudgetContextKey struct{}
+
+// RetryBudget tells hooks running for an Exec or ExecRaw call, such as credentials providers, transports and
+// context metric subscribers, how many attempts and how much time the call has left.
+type RetryBudget struct {
+	maxAttempts int32
+	attempt     int32
+	deadline    time.Time
+	clock       Clock
+
+	retryMax       int
+	maxElapsed     time.Duration
+	requestTimeout time.Duration
+	// stopTimer stops the MaxElapsedTime timer without cancelling the context.
+	stopTimer func() bool
+}
+
+// RetryBudgetFromContext returns the budget of the call the context belongs to.
+func RetryBudgetFromContext(ctx context.Context) (*RetryBudget, bool) {
+	budget, ok := ctx.Value(retryBudgetContextKey{}).(*RetryBudget)
+	return budget, ok
+}
+
+// withRetryBudget attaches the budget of a call to its context, applying the endpoint profile matching the
+// resource path. When MaxElapsedTime is configured the returned context is also cancelled once it elapses.
+func (cli *OpsGenieClient) withRetryBudget(ctx context.Context, resourcePath string) (context.Context, context.CancelFunc) {
+	clock := cli.Clock()
+	profile := cli.endpointProfile(resourcePath)
+	budget := &RetryBudget{
+		retryMax:       profile.retryMax(cli.RetryableClient.RetryMax),
+		maxElapsed:     cli.Config.MaxElapsedTime,
+		requestTimeout: profile.RequestTimeout,
+		clock:          clock,
+	}
+	if profile.MaxElapsedTime > 0 {
+		budget.maxElapsed = profile.MaxElapsedTime
+	}
+	budget.maxAttempts = int32(budget.retryMax + 1)
+	if deadline, ok := ctx.Deadline(); ok {
+		budget.deadline = deadline
+	}
+	cancel := func() {}
+	if maxElapsed := budget.maxElapsed; maxElapsed > 0 {
+		if deadline := clock.Now().Add(maxElapsed); budget.deadline.IsZero() || deadline.Before(budget.deadline) {
+			budget.deadline = deadline
+		}
+		var cancelCtx context.CancelFunc
+		ctx, cancelCtx = context.WithCancel(ctx)
+		stop := clock.AfterFunc(maxElapsed, cancelCtx)
+		budget.stopTimer = stop
+		cancel = func() {
+			stop()
+			cancelCtx()
+		}
+	}
+	return context.WithValue(ctx, retryBudgetContextKey{}, budget), cancel
+}
+
+// exceedsMaxElapsedTime reports whether waiting before the next attempt would exceed MaxElapsedTime.
+func (cli *OpsGenieClient) exceedsMaxElapsedTime(budget *RetryBudget, wait time.Duration) bool {
+	if budget == nil || budget.maxElapsed <= 0 {
+		return false
+	}
+	remaining, ok := budget.RemainingTime()
+	return ok && wait >= remaining
+}
+
+// Attempt returns the current attempt, starting at 1, or 0 before the request is sent.
+func (b *RetryBudget) Attempt() int {
+	return int(atomic.LoadInt32(&b.attempt))
+}
+
+// RemainingAttempts returns the number of attempts left after the current one.
+func (b *RetryBudget) RemainingAttempts() int {
+	remaining := atomic.LoadInt32(&b.maxAttempts) - atomic.LoadInt32(&b.attempt)
+	if remaining < 0 {
+		return 0
+	}
+	return int(remaining)
+}
+
+// RemainingTime returns the time left until the deadline of the call, if it has one.
+func (b *RetryBudget) RemainingTime() (time.Duration, bool) {
+	if b.deadline.IsZero() {
+		return 0, false
+	}
+	remaining := b.deadline.Sub(b.clock.Now())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+func (b *RetryBudget) setAttempt(attempt int) {
+	atomic.StoreInt32(&b.attempt, int32(attempt))
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+const defaultCacheEntries = 1000
+
+// ResponseCache stores GET responses that carry an ETag or Last-Modified header, keyed by resource path and query.
+// Later requests for the same resource are sent as conditional requests, and a 304 Not Modified response is
+// answered from the cache so it can be parsed like the original response. A cache can be shared by clients that
+// use the same API key.
+type ResponseCache struct {
+	MaxEntries int
+
+	mux     sync.Mutex
+	entries map[string]*cacheEntry
+	order   []string
+}
+
+type cacheEntry struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{MaxEntries: defaultCacheEntries, entries: make(map[string]*cacheEntry)}
+}
+
+func cacheKey(req *request) string {
+	return req.URL.Path + "?" + req.URL.RawQuery
+}
+
+func (c *ResponseCache) get(key string) *cacheEntry {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.entries[key]
+}
+
+func (c *ResponseCache) put(key string, entry *cacheEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+	}
+	if _, ok := c.entries[key]; !ok {
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = entry
+	for c.MaxEntries > 0 && len(c.order) > c.MaxEntries {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// setValidators adds the conditional request headers of the cached response, if any.
+func (c *ResponseCache) setValidators(req *request) {
+	if req.Method != http.MethodGet {
+		return
+	}
+	entry := c.get(cacheKey(req))
+	if entry == nil {
+		return
+	}
+	if entry.etag != "" {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	if entry.lastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.lastModified)
+	}
+}
+
+// notModified returns the cached response a 304 response to the request refers to. If it was evicted after the
+// validators were set, the 304 response cannot be answered from the cache, so the request is sent again without
+// its conditional headers.
+func (c *ResponseCache) notModified(req *request, response *http.Response, send func() (*http.Response, error)) (*http.Response, *cacheEntry, error) {
+	if req.Method != http.MethodGet || response.StatusCode != http.StatusNotModified {
+		return response, nil, nil
+	}
+	if entry := c.get(cacheKey(req)); entry != nil {
+		return response, entry, nil
+	}
+	response.Body.Close()
+	req.Header.Del("If-None-Match")
+	req.Header.Del("If-Modified-Since")
+	response, err := send()
+	return response, nil, err
+}
+
+// update stores cacheable responses and replaces the body of a 304 response with the one of the cached entry.
+func (c *ResponseCache) update(req *request, response *http.Response, entry *cacheEntry) error {
+	if req.Method != http.MethodGet {
+		return nil
+	}
+
+	if response.StatusCode == http.StatusNotModified {
+		if entry == nil {
+			return nil
+		}
+		response.Body.Close()
+		response.StatusCode = http.StatusOK
+		response.Status = http.StatusText(http.StatusOK)
+		for name, values := range entry.header {
+			if response.Header.Get(name) == "" {
+				response.Header[name] = append([]string(nil), values...)
+			}
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(entry.body))
+		return nil
+	}
+
+	etag := response.Header.Get("ETag")
+	lastModified := response.Header.Get("Last-Modified")
+	if response.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return nil
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	c.put(cacheKey(req), &cacheEntry{etag: etag, lastModified: lastModified, header: cacheableHeader(response.Header), body: body})
+	return nil
+}
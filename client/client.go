@@ -3,7 +3,6 @@ package client
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -25,10 +24,26 @@ import (
 type OpsGenieClient struct {
 	RetryableClient *retryablehttp.Client
 	Config          *Config
+
+	lifecycle lifecycle
+	health    healthTracker
+	usage     usageTracker
+	inflight  coalescer
+	keys      keyFailover
+	// providedKeys are the keys the credentials provider returned, redacted from debug output.
+	providedKeys providedKeys
+	// metrics queues the metrics of the client when Config.MetricPublishing is set.
+	metrics *metricQueue
+	// ownTransport is the copy of the configured transport that transport settings are applied to.
+	ownTransport *http.Transport
 }
 
 type request struct {
 	*retryablehttp.Request
+	// attempts is set when the request failed on its last retry.
+	attempts []AttemptSummary
+	// keyIndex is the index of the key of Config.ApiKeys the request is authenticated with.
+	keyIndex int
 }
 
 type ApiRequest interface {
@@ -69,8 +84,9 @@ type AsyncBaseResult struct {
 
 func (ar *AsyncBaseResult) RetrieveStatus(ctx context.Context, request ApiRequest, result ApiResult) error {
 
-	if ctx == nil {
-		ctx = context.Background()
+	ctx, err := ar.Client.defaultContext(ctx)
+	if err != nil {
+		return err
 	}
 
 	for i := 0; ; i++ {
@@ -96,8 +112,8 @@ func (ar *AsyncBaseResult) RetrieveStatus(ctx context.Context, request ApiReques
 
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(wait):
+			return errors.Wrapf(ctx.Err(), "Request status was not retrieved after %d attempts", i+1)
+		case <-ar.Client.Clock().After(wait):
 		}
 	}
 }
@@ -114,7 +130,25 @@ type ResultMetadata struct {
 	RateLimitState  string
 	RateLimitReason string
 	RateLimitPeriod string
-	RetryCount      int
+	// RateLimitRemaining and RateLimitLimit are the requests left and allowed in the rate limit period.
+	// Both are zero when the response did not carry them.
+	RateLimitRemaining int `json:"-"`
+	RateLimitLimit     int `json:"-"`
+	RetryCount         int
+	// ThrottledCount is the number of attempts that got a 429 response before the request succeeded.
+	ThrottledCount int `json:"-"`
+	IdempotencyKey string
+	// Deprecation is the Deprecation header sent for deprecated endpoints.
+	Deprecation string `json:"-"`
+	// Date is the time the response was generated at, zero if the Date header is missing.
+	Date time.Time `json:"-"`
+	// ContentLength is the length of the response body, -1 if unknown.
+	ContentLength int64 `json:"-"`
+	// Headers holds all headers of the response.
+	Headers http.Header `json:"-"`
+	// AlreadyAbsent is set when a removal got a 404 response that was treated as success, see
+	// Config.IgnoreNotFoundOnRemoval.
+	AlreadyAbsent bool `json:"-"`
 }
 
 func (rm *ResultMetadata) setResultMetadata(metadata *ResultMetadata) *ResultMetadata {
@@ -127,10 +161,32 @@ func (rm *ResultMetadata) setResultMetadata(metadata *ResultMetadata) *ResultMet
 	rm.RateLimitState = metadata.RateLimitState
 	rm.RateLimitReason = metadata.RateLimitReason
 	rm.RateLimitPeriod = metadata.RateLimitPeriod
+	rm.RateLimitRemaining = metadata.RateLimitRemaining
+	rm.RateLimitLimit = metadata.RateLimitLimit
 	rm.RetryCount = metadata.RetryCount
+	rm.ThrottledCount = metadata.ThrottledCount
+	rm.IdempotencyKey = metadata.IdempotencyKey
+	rm.Deprecation = metadata.Deprecation
+	rm.Date = metadata.Date
+	rm.ContentLength = metadata.ContentLength
+	rm.Headers = metadata.Headers
 	return rm
 }
 
+// TimeUntilRefill returns how long to wait before the next request to stay within the rate limit, assuming the
+// limit refills evenly over its period: zero while requests remain, otherwise the time it takes to refill one
+// request. It reports false when the response did not carry the limit and period.
+func (rm *ResultMetadata) TimeUntilRefill() (time.Duration, bool) {
+	period, err := strconv.ParseFloat(rm.RateLimitPeriod, 64)
+	if err != nil || period <= 0 || rm.RateLimitLimit <= 0 {
+		return 0, false
+	}
+	if rm.RateLimitRemaining > 0 {
+		return 0, true
+	}
+	return time.Duration(period * float64(time.Second) / float64(rm.RateLimitLimit)), true
+}
+
 func (rm *ResultMetadata) ValidateResultMetadata() error {
 	unsetFields := ""
 
@@ -156,20 +212,36 @@ var UserAgentHeader string
 
 const Version = "2.0.0"
 
+const SdkVersionHeader = "X-Opsgenie-Sdk-Version"
+
+// retryCountHeader and throttledCountHeader carry ResultMetadata.RetryCount and ThrottledCount from do to
+// setResultMetadata.
+const (
+	retryCountHeader     = "retryCount"
+	throttledCountHeader = "throttledCount"
+)
+
 func setConfiguration(opsGenieClient *OpsGenieClient, cfg *Config) {
 	opsGenieClient.RetryableClient.ErrorHandler = opsGenieClient.defineErrorHandler
 	if cfg.OpsGenieAPIURL == "" {
-		cfg.OpsGenieAPIURL = API_URL
+		if cfg.Region != "" {
+			cfg.OpsGenieAPIURL = cfg.Region.ApiUrl()
+		} else {
+			cfg.OpsGenieAPIURL = API_URL
+		}
 	}
 	if cfg.HttpClient != nil {
-		opsGenieClient.RetryableClient.HTTPClient = cfg.HttpClient
+		// The http client is copied, so setting timeouts, transports and redirect policies never changes a client
+		// the caller shares, such as http.DefaultClient.
+		httpClient := *cfg.HttpClient
+		opsGenieClient.RetryableClient.HTTPClient = &httpClient
+	}
+	if cfg.Transport != nil {
+		opsGenieClient.RetryableClient.HTTPClient.Transport = cfg.Transport
 	}
 	if cfg.RequestTimeout != 0 {
 		opsGenieClient.RetryableClient.HTTPClient.Timeout = cfg.RequestTimeout
 	}
-	if cfg.ProxyConfiguration != nil {
-		setProxySettings(opsGenieClient)
-	}
 	opsGenieClient.Config.apiUrl = string(cfg.OpsGenieAPIURL)
 }
 
@@ -193,6 +265,14 @@ func setRetryPolicy(opsGenieClient *OpsGenieClient, cfg *Config) {
 	//custom backoff
 	if cfg.Backoff != nil {
 		opsGenieClient.RetryableClient.Backoff = cfg.Backoff
+	} else if cfg.Jitter != NoJitter {
+		opsGenieClient.RetryableClient.Backoff = jitterBackoff(cfg.Jitter)
+	}
+	if cfg.RetryWaitMin > 0 {
+		opsGenieClient.RetryableClient.RetryWaitMin = cfg.RetryWaitMin
+	}
+	if cfg.RetryWaitMax > 0 {
+		opsGenieClient.RetryableClient.RetryWaitMax = cfg.RetryWaitMax
 	}
 
 	//custom retry policy
@@ -205,7 +285,7 @@ func setRetryPolicy(opsGenieClient *OpsGenieClient, cfg *Config) {
 			}
 
 			if err != nil {
-				return true, err
+				return !isRedirectLimitError(err), err
 			}
 			// Check the response code. We retry on 500-range responses to allow
 			// the server time to recover, as 500's are typically not permanent
@@ -239,10 +319,38 @@ func NewOpsGenieClient(cfg *Config) (*OpsGenieClient, error) {
 		return nil, cfg.Validate()
 	}
 	setConfiguration(opsGenieClient, cfg)
+	if cfg.MetricPublishing != nil {
+		opsGenieClient.metrics = newMetricQueue(*cfg.MetricPublishing)
+	}
+	if cfg.ProxyConfiguration != nil {
+		if err := setProxySettings(opsGenieClient); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.TLSConfiguration != nil {
+		if err := setTLSSettings(opsGenieClient); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.DNSConfiguration != nil {
+		if err := setDNSSettings(opsGenieClient); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.connectionPoolConfigured() {
+		if err := setConnectionPoolSettings(opsGenieClient); err != nil {
+			return nil, err
+		}
+	}
+	setRedirectPolicy(opsGenieClient)
 	opsGenieClient.RetryableClient.Logger = nil //disable retryableClient's uncustomizable logging
 	setLogger(cfg)
 	setRetryPolicy(opsGenieClient, cfg)
+	opsGenieClient.usage.since = opsGenieClient.Clock().Now()
 	printInfoLog(opsGenieClient)
+	if cfg.AuditOnStartup {
+		opsGenieClient.logAudit()
+	}
 	return opsGenieClient, nil
 }
 
@@ -261,29 +369,170 @@ func (cli *OpsGenieClient) defineErrorHandler(resp *http.Response, err error, nu
 		}
 		return nil, err
 	}
-	resp.Header.Add("retryCount", strconv.Itoa(numTries))
+	resp.Header.Add(retryCountHeader, strconv.Itoa(numTries))
 	cli.Config.Logger.Errorf("Failed to process request after %d attempts.", numTries)
 	return resp, nil
 }
 
-func (cli *OpsGenieClient) do(request *request) (*http.Response, error) {
-	return cli.RetryableClient.Do(request.Request)
+func (cli *OpsGenieClient) do(request *request, transactionId string, resourcePath string) (*http.Response, error) {
+	retryableClient := cli.RetryableClient
+	attempts := cli.newAttemptLog()
+	var response *http.Response
+	var err error
+
+	budget, _ := RetryBudgetFromContext(request.Context())
+	retryMax := retryableClient.RetryMax
+	if budget != nil {
+		retryMax = budget.retryMax
+	}
+
+	tries := 0
+	throttled := 0
+	// retryBase is the attempt retries are counted from; every API key failover starts over.
+	retryBase := 0
+	for i := 0; ; i++ {
+		if budget != nil {
+			budget.setAttempt(i + 1)
+		}
+		if request.GetBody != nil {
+			body, bodyErr := request.GetBody()
+			if bodyErr != nil {
+				return response, bodyErr
+			}
+			request.Body = body
+		}
+
+		if cli.Config.DebugHttp {
+			cli.dumpRequest(request.Request.Request)
+		}
+		attempt := AttemptEvent{TransactionId: transactionId, ResourcePath: resourcePath, Request: request.Request.Request, Attempt: i + 1}
+		cli.hookRequestStart(attempt)
+		start := cli.Clock().Now()
+		attemptRequest, cancelAttempt := withAttemptTimeout(request.Request.Request, budget)
+		response, err = cli.send(attemptRequest)
+		if cancelAttempt != nil && response != nil {
+			// The timeout keeps running while the body is read, like the timeout of the http client.
+			response.Body = &cancelOnClose{ReadCloser: response.Body, cancel: cancelAttempt}
+		} else if cancelAttempt != nil {
+			cancelAttempt()
+		}
+		elapsed := cli.Clock().Now().Sub(start)
+		cli.recordAttempt(resourcePath, i+1, request.Request.Request, response)
+		cli.hookResponse(attempt, response, elapsed, err)
+		if cli.Config.DebugHttp && response != nil {
+			cli.dumpResponse(response)
+		}
+		if err == nil && shouldFailover(response, false) && cli.failover(request, response) {
+			attempts.add(i+1, elapsed, response, err, drainBody(response.Body))
+			retryBase = i + 1
+			continue
+		}
+
+		shouldRetry, checkErr := retryableClient.CheckRetry(request.Context(), response, err)
+		if !shouldRetry {
+			if checkErr != nil {
+				err = checkErr
+			}
+			if err == nil && response != nil && i > 0 {
+				response.Header.Set(retryCountHeader, strconv.Itoa(i))
+				response.Header.Set(throttledCountHeader, strconv.Itoa(throttled))
+			}
+			return response, err
+		}
+		if err == nil && response != nil && response.StatusCode == http.StatusTooManyRequests {
+			throttled++
+		}
+
+		tries = i + 1
+		exhausted := retryMax-(i-retryBase) <= 0
+		var wait time.Duration
+		if !exhausted {
+			wait = retryableClient.Backoff(retryableClient.RetryWaitMin, retryableClient.RetryWaitMax, i-retryBase, response)
+			exhausted = cli.exceedsMaxElapsedTime(budget, wait)
+		}
+		if exhausted && err == nil && shouldFailover(response, true) && cli.failover(request, response) {
+			attempts.add(i+1, elapsed, response, err, drainBody(response.Body))
+			retryBase = i + 1
+			continue
+		}
+		if exhausted {
+			var excerpt string
+			if err == nil && response != nil {
+				excerpt = peekBody(response)
+			}
+			attempts.add(i+1, elapsed, response, err, excerpt)
+			break
+		}
+
+		var excerpt string
+		if err == nil && response != nil {
+			excerpt = drainBody(response.Body)
+		}
+		attempts.add(i+1, elapsed, response, err, excerpt)
+
+		retryEvent := buildRetryEvent(transactionId, resourcePath, request.Method, i+1, wait, response, err)
+		cli.publishRetryEvent(retryEvent)
+		cli.hookRetry(attempt, retryEvent)
+		select {
+		case <-request.Context().Done():
+			request.attempts = attempts.summaries
+			return nil, &RetryCancelledError{
+				Err:      errors.Wrapf(request.Context().Err(), "%s %s cancelled while waiting to retry after %d attempts", request.Method, request.URL, i+1),
+				Attempts: request.attempts,
+			}
+		case <-cli.Clock().After(wait):
+		}
+	}
+
+	request.attempts = attempts.summaries
+	if retryableClient.ErrorHandler != nil {
+		response, err = retryableClient.ErrorHandler(response, err, tries)
+		if err != nil {
+			err = &RetriesExhaustedError{Err: err, Attempts: request.attempts}
+		}
+		return response, err
+	}
+	if response != nil {
+		response.Body.Close()
+	}
+	return nil, &RetriesExhaustedError{
+		Err:      errors.Errorf("%s %s giving up after %d attempts", request.Method, request.URL, tries),
+		Attempts: request.attempts,
+	}
 }
 
 func setResultMetadata(httpResponse *http.Response, result ApiResult) *ResultMetadata {
 	responseTime := httpResponse.Header.Get("X-Response-Time")
 
-	retryCount, err := strconv.Atoi(httpResponse.Header.Get("retryCount"))
+	retryCount, err := strconv.Atoi(httpResponse.Header.Get(retryCountHeader))
 	responseTimeInFloat, err2 := strconv.ParseFloat(responseTime, 32)
 	resultMetadata := &ResultMetadata{
 		RequestId:       httpResponse.Header.Get("X-Request-Id"),
 		RateLimitState:  httpResponse.Header.Get("X-RateLimit-State"),
 		RateLimitReason: httpResponse.Header.Get("X-RateLimit-Reason"),
 		RateLimitPeriod: httpResponse.Header.Get("X-RateLimit-Period-In-Sec"),
+		Deprecation:     httpResponse.Header.Get("Deprecation"),
+		ContentLength:   httpResponse.ContentLength,
+		Headers:         httpResponse.Header,
+	}
+	if remaining, convErr := strconv.Atoi(httpResponse.Header.Get("X-RateLimit-Remaining")); convErr == nil {
+		resultMetadata.RateLimitRemaining = remaining
+	}
+	if limit, convErr := strconv.Atoi(httpResponse.Header.Get("X-RateLimit-Limit")); convErr == nil {
+		resultMetadata.RateLimitLimit = limit
+	}
+	if date, dateErr := http.ParseTime(httpResponse.Header.Get("Date")); dateErr == nil {
+		resultMetadata.Date = date
 	}
 	if err == nil {
 		resultMetadata.RetryCount = retryCount
 	}
+	if throttled, convErr := strconv.Atoi(httpResponse.Header.Get(throttledCountHeader)); convErr == nil {
+		resultMetadata.ThrottledCount = throttled
+	}
+	if httpResponse.Request != nil {
+		resultMetadata.IdempotencyKey = httpResponse.Request.Header.Get(IdempotencyKeyHeader)
+	}
 	if err2 == nil {
 		resultMetadata.ResponseTime = float32(responseTimeInFloat)
 	}
@@ -292,15 +541,26 @@ func setResultMetadata(httpResponse *http.Response, result ApiResult) *ResultMet
 
 type ApiError struct {
 	error
-	Message     string            `json:"message"`
-	Took        float32           `json:"took"`
-	RequestId   string            `json:"requestId"`
-	Errors      map[string]string `json:"errors"`
-	StatusCode  int
-	ErrorHeader string
+	Message        string            `json:"message"`
+	Took           float32           `json:"took"`
+	RequestId      string            `json:"requestId"`
+	Errors         map[string]string `json:"errors"`
+	StatusCode     int
+	ErrorHeader    string
+	RateLimitState string `json:"-"`
+	Body           string `json:"-"`
+	// Attempts summarizes the last attempts when the request failed on its last retry.
+	Attempts []AttemptSummary `json:"-"`
+	// FieldErrors holds Errors parsed into field paths.
+	FieldErrors FieldErrors `json:"-"`
+
+	shortMessage bool
 }
 
 func (ar *ApiError) Error() string {
+	if ar.shortMessage {
+		return ar.shortError()
+	}
 	errMessage := "Error occurred with Status code: " + strconv.Itoa(ar.StatusCode) + ", " +
 		"Message: " + ar.Message + ", " +
 		"Took: " + fmt.Sprintf("%f", ar.Took) + ", " +
@@ -319,8 +579,16 @@ func handleErrorIfExist(response *http.Response) error {
 		apiError := &ApiError{}
 		apiError.StatusCode = response.StatusCode
 		apiError.ErrorHeader = response.Header.Get("X-Opsgenie-Errortype")
+		apiError.RateLimitState = response.Header.Get("X-RateLimit-State")
 		body, _ := ioutil.ReadAll(response.Body)
-		json.Unmarshal(body, apiError)
+		serializerOf(response).Unmarshal(body, apiError)
+		apiError.FieldErrors = newFieldErrors(apiError.Errors)
+		if apiError.RequestId == "" {
+			apiError.RequestId = response.Header.Get("X-Request-Id")
+		}
+		snippet := &bodySnippet{}
+		snippet.Write(body)
+		apiError.Body = snippet.String()
 		return apiError
 	}
 	return nil
@@ -330,13 +598,21 @@ func (cli *OpsGenieClient) buildHttpRequest(apiRequest ApiRequest) (*request, er
 	var buf io.ReadWriter
 	var contentType = new(string)
 	var err error
-	var req = new(retryablehttp.Request)
+
+	var getBody func() (io.ReadCloser, error)
 
 	details := apiRequest.Metadata(apiRequest)
-	if values, ok := details["form-data-values"].(map[string]io.Reader); ok {
+	if prepared, ok := apiRequest.(*templateRequest); ok {
+		if prepared.body != nil {
+			*contentType = details["Content-Type"].(string)
+			buf = bytes.NewBuffer(prepared.body)
+		}
+	} else if provider, ok := apiRequest.(FileProvider); ok {
+		getBody, *contentType, err = newMultipartBody(provider)
+	} else if values, ok := details["form-data-values"].(map[string]io.Reader); ok {
 		setBodyAsFormData(&buf, values, contentType)
 	} else if apiRequest.Method() != http.MethodGet && apiRequest.Method() != http.MethodDelete {
-		err = setBodyAsJson(&buf, apiRequest, contentType, details)
+		err = setBodyAsJson(&buf, apiRequest, contentType, details, cli.serializer())
 	}
 	if err != nil {
 		return nil, err
@@ -347,31 +623,68 @@ func (cli *OpsGenieClient) buildHttpRequest(apiRequest ApiRequest) (*request, er
 		queryParams.Add(key, value)
 	}
 
-	req, err = retryablehttp.NewRequest(apiRequest.Method(), buildRequestUrl(cli, apiRequest, queryParams), buf)
+	var payload []byte
+	if body, ok := buf.(*bytes.Buffer); ok {
+		payload = body.Bytes()
+	}
+
+	req, err := cli.newRequest(apiRequest.Method(), buildRequestUrl(cli, apiRequest, queryParams), payload, *contentType)
 	if err != nil {
 		return nil, err
 	}
+	if getBody != nil {
+		req.GetBody = getBody
+	}
+	return req, nil
+}
 
-	if contentType != nil {
-		req.Header.Add("Content-Type", *contentType)
+func (cli *OpsGenieClient) newRequest(method string, requestUrl string, payload []byte, contentType string) (*request, error) {
+	var body interface{}
+	if payload != nil {
+		body = payload
+	}
+	req, err := retryablehttp.NewRequest(method, requestUrl, body)
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(payload)), nil
+		}
+	}
+
+	if contentType != "" {
+		req.Header.Add("Content-Type", contentType)
 	} else {
 		req.Header.Add("Content-Type", "application/json")
 	}
 	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Authorization", "GenieKey "+cli.Config.ApiKey)
-	req.Header.Add("User-Agent", UserAgentHeader)
-
-	return &request{req}, err
+	if cli.Config.UserAgent != "" {
+		req.Header.Add("User-Agent", UserAgentHeader+" "+cli.Config.UserAgent)
+	} else {
+		req.Header.Add("User-Agent", UserAgentHeader)
+	}
+	req.Header.Add(SdkVersionHeader, Version)
 
+	return &request{Request: req}, nil
 }
 
 func buildRequestUrl(cli *OpsGenieClient, apiRequest ApiRequest, queryParams url.Values) string {
+	return buildUrl(cli, apiRequest.ResourcePath(), queryParams)
+}
+
+func buildUrl(cli *OpsGenieClient, path string, queryParams url.Values) string {
 	requestUrl := url.URL{
 		Scheme:   string(Https),
 		Host:     cli.Config.apiUrl,
-		Path:     apiRequest.ResourcePath(),
+		Path:     path,
 		RawQuery: queryParams.Encode(),
 	}
+	// Identifier segments are escaped by PathSegment, keep them escaped so "/" in an identifier stays in its segment.
+	if unescaped, err := url.PathUnescape(path); err == nil {
+		requestUrl.Path = unescaped
+		requestUrl.RawPath = path
+	}
 	//test purposes only
 	if !strings.Contains(cli.Config.apiUrl, "api") {
 		requestUrl.Scheme = "http"
@@ -380,31 +693,27 @@ func buildRequestUrl(cli *OpsGenieClient, apiRequest ApiRequest, queryParams url
 	return requestUrl.String()
 }
 
-func setProxySettings(cli *OpsGenieClient) {
-	proxy := cli.Config.ProxyConfiguration.Host
-	if cli.Config.ProxyConfiguration.Port != 0 {
-		proxy = proxy + ":" + strconv.Itoa(cli.Config.ProxyConfiguration.Port)
-	}
-	proxyUrl := &url.URL{
-		Host:   proxy,
-		Scheme: string(cli.Config.ProxyConfiguration.Protocol),
+func setProxySettings(cli *OpsGenieClient) error {
+	proxyFunc := cli.Config.ProxyConfiguration.ProxyFunc
+	if proxyFunc == nil {
+		proxyFunc = http.ProxyURL(cli.Config.ProxyConfiguration.url())
 	}
-	if cli.Config.ProxyConfiguration.Username != "" {
-		proxyUrl.User = url.UserPassword(cli.Config.ProxyConfiguration.Username, cli.Config.ProxyConfiguration.Password)
-	}
-	cli.RetryableClient.HTTPClient.Transport = &http.Transport{
-		Proxy: http.ProxyURL(proxyUrl),
+	t, err := transport(cli)
+	if err != nil {
+		return err
 	}
+	t.Proxy = proxyFunc
+	return nil
 }
 
-func setBodyAsJson(buf *io.ReadWriter, apiRequest ApiRequest, contentType *string, details map[string]interface{}) error {
-	*buf = new(bytes.Buffer)
+func setBodyAsJson(buf *io.ReadWriter, apiRequest ApiRequest, contentType *string, details map[string]interface{}, serializer Serializer) error {
 	*contentType = details["Content-Type"].(string)
 
-	err := json.NewEncoder(*buf).Encode(apiRequest)
+	body, err := serializer.Marshal(apiRequest)
 	if err != nil {
 		return err
 	}
+	*buf = bytes.NewBuffer(body)
 
 	return nil
 }
@@ -441,27 +750,83 @@ func setBodyAsFormData(buf *io.ReadWriter, values map[string]io.Reader, contentT
 }
 
 func (cli *OpsGenieClient) Exec(ctx context.Context, request ApiRequest, result ApiResult) error {
-	startTime := time.Now().UnixNano()
-	transactionId := generateTransactionId()
+	ctx, err := cli.defaultContext(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = cli.withAccount(ctx)
+	ctx, cancel := cli.withRetryBudget(ctx, request.ResourcePath())
+	defer cancel()
+	done, err := cli.begin()
+	if err != nil {
+		return err
+	}
+	defer done()
+	startTime := cli.now()
+	transactionId := cli.newId()
 	cli.Config.Logger.Debugf("Starting to process Request %+v: to send: %s", request, request.ResourcePath())
-	if err := request.Validate(); err != nil {
+	request = cli.applyActor(ctx, request)
+	if err := validateRequest(request); err != nil {
 		cli.Config.Logger.Errorf("Request validation err: %s ", err.Error())
-		metricPublisher.publish(buildSdkMetric(transactionId, request.ResourcePath(), "request-validation-error", err, request, result, duration(startTime, time.Now().UnixNano())))
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "request-validation-error", err, request, result, duration(startTime, cli.now())))
 		return err
 	}
 	req, err := cli.buildHttpRequest(request)
 	if err != nil {
 		cli.Config.Logger.Errorf("Could not create request: %s", err.Error())
-		metricPublisher.publish(buildSdkMetric(transactionId, request.ResourcePath(), "sdk-error", err, request, result, duration(startTime, time.Now().UnixNano())))
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "sdk-error", err, request, result, duration(startTime, cli.now())))
 		return err
 	}
-	if ctx != nil {
-		req.WithContext(ctx)
+	req.WithContext(withSerializer(ctx, cli.serializer()))
+	setCustomHeaders(ctx, req)
+	if err := cli.setAuthorization(ctx, req); err != nil {
+		cli.Config.Logger.Errorf(err.Error())
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "credentials-error", err, request, result, duration(startTime, cli.now())))
+		return err
+	}
+	cli.setIdempotencyKey(ctx, req)
+	_, streaming := result.(streamingResult)
+	if streaming {
+		req.Header.Set("Accept", "*/*")
+	} else if cli.Config.ResponseCache != nil {
+		cli.Config.ResponseCache.setValidators(req)
 	}
 
-	response, err := cli.do(req)
-	if response != nil {
-		metricPublisher.publish(buildHttpMetric(transactionId, request.ResourcePath(), response, err, duration(startTime, time.Now().UnixNano()), *req))
+	if cli.isDryRun(ctx) {
+		response, err := cli.dryRun(req, transactionId)
+		if err != nil {
+			cli.Config.Logger.Errorf("Could not dump request: %s", err.Error())
+			return err
+		}
+		setResultMetadata(response, result)
+		return nil
+	}
+
+	var response *http.Response
+	var notModified *cacheEntry
+	cached := false
+	if !streaming {
+		response, cached = cli.Config.DiskCache.lookup(req, cli.Clock().Now())
+	}
+	if cached {
+		cli.Config.Logger.Debugf("Serving %s from the disk cache", request.ResourcePath())
+	} else {
+		do := cli.doCoalesced
+		if streaming {
+			// Streamed bodies are not read at once, so they cannot be shared.
+			do = cli.do
+		}
+		response, err = do(req, transactionId, request.ResourcePath())
+		if err == nil && cli.Config.ResponseCache != nil && !streaming {
+			response, notModified, err = cli.Config.ResponseCache.notModified(req, response, func() (*http.Response, error) {
+				return do(req, transactionId, request.ResourcePath())
+			})
+		}
+		cli.recordHealth(request.ResourcePath(), response, err)
+		cli.recordCall(request.ResourcePath(), response, err)
+		if response != nil {
+			cli.publishMetric(ctx, buildHttpMetric(transactionId, request.ResourcePath(), response, err, duration(startTime, cli.now()), *req))
+		}
 	}
 	if err != nil {
 		cli.Config.Logger.Errorf(err.Error())
@@ -470,32 +835,162 @@ func (cli *OpsGenieClient) Exec(ctx context.Context, request ApiRequest, result
 
 	defer response.Body.Close()
 
+	err = decodeContentEncoding(response)
+	if err != nil {
+		cli.Config.Logger.Errorf(err.Error())
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "response-decoding-error", err, request, result, duration(startTime, cli.now())))
+		return err
+	}
+
+	if cli.Config.MaxResponseSize > 0 {
+		err = limitResponseSize(response, request.ResourcePath(), cli.Config.MaxResponseSize)
+		if err != nil {
+			cli.Config.Logger.Errorf(err.Error())
+			cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "response-size-error", err, request, result, duration(startTime, cli.now())))
+			return err
+		}
+	}
+
+	if !streaming {
+		err = normalizeCharset(response)
+		if err != nil {
+			cli.Config.Logger.Errorf(err.Error())
+			cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "response-decoding-error", err, request, result, duration(startTime, cli.now())))
+			return err
+		}
+	}
+
+	if cli.Config.ResponseCache != nil && !streaming {
+		err = cli.Config.ResponseCache.update(req, response, notModified)
+		if err != nil {
+			cli.Config.Logger.Errorf(err.Error())
+			return err
+		}
+	}
+
+	if !cached && !streaming {
+		if err := cli.Config.DiskCache.store(req, response, cli.Clock().Now()); err != nil {
+			cli.Config.Logger.Warnf("Could not store the response of %s in the disk cache: %s", request.ResourcePath(), err.Error())
+		}
+	}
+
 	err = handleErrorIfExist(response)
+	if apiErr, ok := err.(*ApiError); ok {
+		apiErr.Attempts = req.attempts
+		apiErr.shortMessage = !cli.compatibility().LegacyErrorStrings
+	}
+	if cli.alreadyAbsent(ctx, request, err) {
+		cli.Config.Logger.Debugf("Resource of %s is already absent: %s", request.ResourcePath(), err.Error())
+		rm := setResultMetadata(response, result)
+		rm.AlreadyAbsent = true
+		cli.publishMetric(ctx, buildApiMetric(transactionId, request.ResourcePath(), duration(startTime, cli.now()), *rm, response, nil))
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "", nil, request, result, duration(startTime, cli.now())))
+		return nil
+	}
 	if err != nil {
 		cli.Config.Logger.Errorf(err.Error())
-		metricPublisher.publish(buildApiMetric(transactionId, request.ResourcePath(), duration(startTime, time.Now().UnixNano()), *setResultMetadata(response, result), response, err))
-		metricPublisher.publish(buildSdkMetric(transactionId, request.ResourcePath(), "api-error", err, request, result, duration(startTime, time.Now().UnixNano())))
+		cli.publishMetric(ctx, buildApiMetric(transactionId, request.ResourcePath(), duration(startTime, cli.now()), *setResultMetadata(response, result), response, err))
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "api-error", err, request, result, duration(startTime, cli.now())))
 		return err
 	}
 
+	snippet := captureBodySnippet(response)
 	err = result.Parse(response, result)
 	if err != nil {
+		err = newParseError(response, snippet.String(), err)
 		cli.Config.Logger.Errorf(err.Error())
-		metricPublisher.publish(buildSdkMetric(transactionId, request.ResourcePath(), "http-response-parsing-error", err, request, result, duration(startTime, time.Now().UnixNano())))
+		cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "http-response-parsing-error", err, request, result, duration(startTime, cli.now())))
 		return err
 	}
 
 	rm := setResultMetadata(response, result)
-	metricPublisher.publish(buildApiMetric(transactionId, request.ResourcePath(), duration(startTime, time.Now().UnixNano()), *rm, response, nil))
+	cli.publishMetric(ctx, buildApiMetric(transactionId, request.ResourcePath(), duration(startTime, cli.now()), *rm, response, nil))
 	err = result.ValidateResultMetadata()
 	if err != nil {
 		cli.Config.Logger.Warn(err.Error())
 	}
-	metricPublisher.publish(buildSdkMetric(transactionId, request.ResourcePath(), "", nil, request, result, duration(startTime, time.Now().UnixNano())))
+	cli.publishMetric(ctx, buildSdkMetric(transactionId, request.ResourcePath(), "", nil, request, result, duration(startTime, cli.now())))
 	cli.Config.Logger.Debugf("Request processed. The result: %+v", result)
 	return nil
 }
 
+// ExecRaw sends a request to the given resource path with the client's authentication, retry policy and metrics
+// applied, without validating the request or parsing the response. It can be used to call endpoints the SDK does
+// not support yet. The caller is responsible for closing the response body.
+func (cli *OpsGenieClient) ExecRaw(ctx context.Context, method string, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	ctx, err := cli.defaultContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = cli.withAccount(ctx)
+	if err := validateResourcePath(path); err != nil {
+		return nil, err
+	}
+	ctx, cancel := cli.withRetryBudget(ctx, path)
+	response, err := cli.execRaw(ctx, method, path, query, body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// MaxElapsedTime covers the attempts only, as the caller reads the body after returning. The context is
+	// released once the body is closed.
+	if budget, ok := RetryBudgetFromContext(ctx); ok && budget.stopTimer != nil {
+		budget.stopTimer()
+	}
+	response.Body = &cancelBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+func (cli *OpsGenieClient) execRaw(ctx context.Context, method string, path string, query url.Values, body io.Reader) (*http.Response, error) {
+	done, err := cli.begin()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	startTime := cli.now()
+	transactionId := cli.newId()
+	cli.Config.Logger.Debugf("Starting to process raw request %s %s", method, path)
+
+	var payload []byte
+	contentType := ""
+	if body != nil {
+		var err error
+		payload, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		contentType = "application/json; charset=utf-8"
+	}
+	req, err := cli.newRequest(method, buildUrl(cli, path, query), payload, contentType)
+	if err != nil {
+		cli.Config.Logger.Errorf("Could not create request: %s", err.Error())
+		return nil, err
+	}
+	req.WithContext(withSerializer(ctx, cli.serializer()))
+	setCustomHeaders(ctx, req)
+	if err := cli.setAuthorization(ctx, req); err != nil {
+		cli.Config.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	cli.setIdempotencyKey(ctx, req)
+
+	if cli.isDryRun(ctx) {
+		return cli.dryRun(req, transactionId)
+	}
+
+	response, err := cli.do(req, transactionId, path)
+	cli.recordHealth(path, response, err)
+	cli.recordCall(path, response, err)
+	if response != nil {
+		cli.publishMetric(ctx, buildHttpMetric(transactionId, path, response, err, duration(startTime, cli.now()), *req))
+	}
+	if err != nil {
+		cli.Config.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
 func shouldDataIgnored(result ApiResult) bool {
 	resultType := reflect.TypeOf(result)
 	elem := resultType.Elem()
@@ -520,22 +1015,23 @@ func (rm *ResultMetadata) Parse(response *http.Response, result ApiResult) error
 	}
 
 	payload = body
+	serializer := serializerOf(response)
 
 	if shouldDataIgnored(result) {
 		resultMap := make(map[string]interface{})
-		err = json.Unmarshal(body, &resultMap)
+		err = serializer.Unmarshal(body, &resultMap)
 		if err != nil {
 			return handleParsingErrors(err)
 		}
 		if value, ok := resultMap["data"]; ok {
-			payload, err = json.Marshal(value)
+			payload, err = serializer.Marshal(value)
 			if err != nil {
 				return handleParsingErrors(err)
 			}
 		}
 	}
 
-	err = json.Unmarshal(payload, result)
+	err = serializer.Unmarshal(payload, result)
 
 	if err != nil {
 		return handleParsingErrors(err)
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of a client and of the helpers built on it. It can be replaced with a ManualClock to
+// run time dependent logic deterministically.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	// AfterFunc calls f after the duration. The returned function cancels the call and reports whether it did.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+type systemClock struct{}
+
+// SystemClock is the Clock backed by the time package. It is used when Config.Clock is not set.
+var SystemClock Clock = systemClock{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// Clock returns the clock configured for the client.
+func (cli *OpsGenieClient) Clock() Clock {
+	if cli.Config.Clock == nil {
+		return SystemClock
+	}
+	return cli.Config.Clock
+}
+
+func (cli *OpsGenieClient) now() int64 {
+	return cli.Clock().Now().UnixNano()
+}
+
+// ManualClock is a Clock whose time only moves when Advance is called. Timers that become due while advancing fire
+// in order of their due time, AfterFunc callbacks run synchronously within Advance.
+type ManualClock struct {
+	mux    sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+}
+
+type manualTimer struct {
+	at      time.Time
+	fire    func(now time.Time)
+	stopped bool
+}
+
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+func (c *ManualClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.now
+}
+
+func (c *ManualClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.add(d, func(now time.Time) {
+		ch <- now
+	})
+	return ch
+}
+
+func (c *ManualClock) AfterFunc(d time.Duration, f func()) func() bool {
+	timer := c.add(d, func(time.Time) {
+		f()
+	})
+	return func() bool {
+		c.mux.Lock()
+		defer c.mux.Unlock()
+		if timer.stopped {
+			return false
+		}
+		timer.stopped = true
+		return true
+	}
+}
+
+func (c *ManualClock) add(d time.Duration, fire func(now time.Time)) *manualTimer {
+	c.mux.Lock()
+	timer := &manualTimer{at: c.now.Add(d), fire: fire}
+	if d <= 0 {
+		timer.stopped = true
+		now := c.now
+		c.mux.Unlock()
+		fire(now)
+		return timer
+	}
+	c.timers = append(c.timers, timer)
+	c.mux.Unlock()
+	return timer
+}
+
+// Waiters returns the number of pending timers, which lets tests wait until code under test started waiting.
+func (c *ManualClock) Waiters() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	count := 0
+	for _, timer := range c.timers {
+		if !timer.stopped {
+			count++
+		}
+	}
+	return count
+}
+
+// Advance moves the time forward and fires the timers that became due.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mux.Lock()
+	target := c.now.Add(d)
+	for {
+		sort.SliceStable(c.timers, func(i, j int) bool {
+			return c.timers[i].at.Before(c.timers[j].at)
+		})
+		if len(c.timers) == 0 || c.timers[0].at.After(target) {
+			break
+		}
+		timer := c.timers[0]
+		c.timers = c.timers[1:]
+		if timer.stopped {
+			continue
+		}
+		timer.stopped = true
+		c.now = timer.at
+		c.mux.Unlock()
+		timer.fire(timer.at)
+		c.mux.Lock()
+	}
+	c.now = target
+	c.mux.Unlock()
+}
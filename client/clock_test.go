@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2019, 12, 24, 0, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	var fired []string
+	clock.AfterFunc(2*time.Second, func() { fired = append(fired, "second") })
+	clock.AfterFunc(time.Second, func() { fired = append(fired, "first") })
+	stop := clock.AfterFunc(3*time.Second, func() { fired = append(fired, "stopped") })
+	after := clock.After(time.Second)
+	assert.Equal(t, 4, clock.Waiters())
+
+	assert.True(t, stop())
+	assert.False(t, stop())
+	clock.Advance(5 * time.Second)
+	assert.Equal(t, []string{"first", "second"}, fired)
+	assert.Equal(t, start.Add(time.Second), <-after)
+	assert.Equal(t, start.Add(5*time.Second), clock.Now())
+	assert.Equal(t, 0, clock.Waiters())
+}
+
+func TestRetryBackoffUsesClock(t *testing.T) {
+	attemptCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		if attemptCount == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"Data": "processed", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	clock := NewManualClock(time.Now())
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		Clock:          clock,
+		Backoff: func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return time.Hour
+		},
+	})
+	assert.Nil(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	}()
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(time.Hour)
+	assert.Nil(t, <-done)
+	assert.Equal(t, 2, attemptCount)
+}
+
+func TestRetryBackoffCancelledByContext(t *testing.T) {
+	attemptCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	clock := NewManualClock(time.Now())
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		Clock:          clock,
+		Backoff: func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return time.Hour
+		},
+	})
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- ogClient.Exec(ctx, &testRequest{MandatoryField: "afield"}, &testResult{})
+	}()
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	err = <-done
+
+	cancelled, ok := err.(*RetryCancelledError)
+	assert.True(t, ok)
+	assert.Equal(t, context.Canceled, errors.Cause(err))
+	assert.Equal(t, 1, len(cancelled.Attempts))
+	assert.Equal(t, http.StatusServiceUnavailable, cancelled.Attempts[0].StatusCode)
+	assert.Equal(t, 1, attemptCount)
+}
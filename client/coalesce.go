@@ -0,0 +1,147 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type coalesceContextKey struct{}
+
+// WithCoalescing returns a context that overrides Config.CoalesceGets for the requests executed with it.
+func WithCoalescing(ctx context.Context, coalesce bool) context.Context {
+	return context.WithValue(ctx, coalesceContextKey{}, coalesce)
+}
+
+func (cli *OpsGenieClient) isCoalescing(ctx context.Context) bool {
+	if coalesce, ok := ctx.Value(coalesceContextKey{}).(bool); ok {
+		return coalesce
+	}
+	return cli.Config.CoalesceGets
+}
+
+type inflightCall struct {
+	done     chan struct{}
+	response *http.Response
+	body     []byte
+	attempts []AttemptSummary
+	keyIndex int
+	err      error
+	// waiters is the number of callers waiting for the call. The call is cancelled once all of them stopped waiting.
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// coalescer tracks the GET requests in flight so identical concurrent requests share a single response.
+type coalescer struct {
+	mux   sync.Mutex
+	calls map[string]*inflightCall
+}
+
+// coalescingKey identifies requests that are answered with the same response.
+func coalescingKey(req *request) string {
+	key := req.URL.String() + "\n" + req.Header.Get("Authorization") + "\n" + req.Header.Get("Accept") + "\n" +
+		req.Header.Get("If-None-Match") + "\n" + req.Header.Get("If-Modified-Since")
+	headers := HeadersFromContext(req.Context())
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key += "\n" + name + ": " + strings.Join(headers[name], ", ")
+	}
+	return key
+}
+
+// doCoalesced sends GET requests identical to one already in flight only once: the first caller starts it and all
+// callers wait for its response and get a copy. The shared request does not end with the context of the caller
+// that started it; callers stop waiting when their context is done, and the request is cancelled once no caller
+// waits for it anymore.
+func (cli *OpsGenieClient) doCoalesced(req *request, transactionId string, resourcePath string) (*http.Response, error) {
+	if req.Method != http.MethodGet || !cli.isCoalescing(req.Context()) {
+		return cli.do(req, transactionId, resourcePath)
+	}
+	key := coalescingKey(req)
+	inflight := &cli.inflight
+	inflight.mux.Lock()
+	call, ok := inflight.calls[key]
+	if ok {
+		cli.Config.Logger.Debugf("Sharing the response of an identical request in flight to %s", resourcePath)
+	} else {
+		if inflight.calls == nil {
+			inflight.calls = make(map[string]*inflightCall)
+		}
+		ctx, cancel := context.WithCancel(detachedContext{parent: req.Context()})
+		call = &inflightCall{done: make(chan struct{}), cancel: cancel}
+		inflight.calls[key] = call
+		shared := *req.Request
+		shared.Request = req.Request.Request.WithContext(ctx)
+		shared.Header = cloneHeader(req.Header)
+		go cli.doShared(key, call, &request{Request: &shared, keyIndex: req.keyIndex}, transactionId, resourcePath)
+	}
+	call.waiters++
+	inflight.mux.Unlock()
+
+	select {
+	case <-req.Context().Done():
+		inflight.mux.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			if inflight.calls[key] == call {
+				delete(inflight.calls, key)
+			}
+		}
+		inflight.mux.Unlock()
+		return nil, req.Context().Err()
+	case <-call.done:
+	}
+	req.attempts = call.attempts
+	req.keyIndex = call.keyIndex
+	if call.response == nil {
+		return nil, call.err
+	}
+	return copyResponse(call.response, call.body), call.err
+}
+
+// doShared sends the request shared by the callers of the call and buffers its body for them.
+func (cli *OpsGenieClient) doShared(key string, call *inflightCall, req *request, transactionId string, resourcePath string) {
+	defer call.cancel()
+	response, err := cli.do(req, transactionId, resourcePath)
+	if response != nil && cli.Config.MaxResponseSize > 0 {
+		// The body is buffered before Exec limits it, so it is limited here as well.
+		if limitErr := limitResponseSize(response, resourcePath, cli.Config.MaxResponseSize); limitErr != nil {
+			response.Body.Close()
+			response, err = nil, limitErr
+		}
+	}
+	if response != nil {
+		body, readErr := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if readErr != nil {
+			response, err = nil, readErr
+		}
+		call.body = body
+	}
+	inflight := &cli.inflight
+	inflight.mux.Lock()
+	if inflight.calls[key] == call {
+		delete(inflight.calls, key)
+	}
+	inflight.mux.Unlock()
+	call.response, call.err = response, err
+	call.attempts, call.keyIndex = req.attempts, req.keyIndex
+	close(call.done)
+}
+
+func copyResponse(response *http.Response, body []byte) *http.Response {
+	copied := *response
+	copied.Header = cloneHeader(response.Header)
+	copied.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return &copied
+}
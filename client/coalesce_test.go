@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withoutMetricSubscribers unregisters the subscribers other tests registered until the test ends, as they are not
+// safe for concurrent use.
+func withoutMetricSubscribers() func() {
+	metricPublisher.mux.Lock()
+	subscribers := metricPublisher.SubscriberMap
+	metricPublisher.SubscriberMap = nil
+	metricPublisher.mux.Unlock()
+	return func() {
+		metricPublisher.mux.Lock()
+		metricPublisher.SubscriberMap = subscribers
+		metricPublisher.mux.Unlock()
+	}
+}
+
+func TestCoalesceGets(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		fmt.Fprint(w, `{"Data": "on-call", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		CoalesceGets:   true,
+	})
+	assert.Nil(t, err)
+
+	results := make([]*testResult, 10)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		results[i] = &testResult{}
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = ogClient.Exec(nil, &testGetRequest{testRequest{MandatoryField: "afield"}}, results[i])
+		}(i)
+	}
+	// Give all callers time to find the request in flight.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i := range results {
+		assert.Nil(t, errs[i])
+		assert.Equal(t, "on-call", results[i].Data)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	requestsBefore := atomic.LoadInt32(&requests)
+	err = ogClient.Exec(WithCoalescing(context.Background(), false), &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, requestsBefore+1, atomic.LoadInt32(&requests))
+}
+
+func TestCoalesceGetsOutlivesFirstCaller(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		fmt.Fprintf(w, `{"Data": "%s", "took": 1, "requestId": "rId"}`, r.Header.Get("X-Tenant"))
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		CoalesceGets:   true,
+	})
+	assert.Nil(t, err)
+
+	firstCtx, cancelFirst := context.WithCancel(WithHeaders(context.Background(), http.Header{"X-Tenant": {"a"}}))
+	firstErr := make(chan error)
+	go func() {
+		firstErr <- ogClient.Exec(firstCtx, &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	results := make([]*testResult, 2)
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i, tenant := range []string{"a", "b"} {
+		wg.Add(1)
+		results[i] = &testResult{}
+		go func(i int, tenant string) {
+			defer wg.Done()
+			ctx := WithHeaders(context.Background(), http.Header{"X-Tenant": {tenant}})
+			errs[i] = ogClient.Exec(ctx, &testGetRequest{testRequest{MandatoryField: "afield"}}, results[i])
+		}(i, tenant)
+	}
+	time.Sleep(50 * time.Millisecond)
+	cancelFirst()
+	assert.NotNil(t, <-firstErr)
+	close(release)
+	wg.Wait()
+
+	assert.Nil(t, errs[0])
+	assert.Equal(t, "a", results[0].Data)
+	assert.Nil(t, errs[1])
+	assert.Equal(t, "b", results[1].Data)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestCoalesceGetsLimitsResponseSize(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The body never ends, so buffering it without the limit would not return.
+		fmt.Fprint(w, `{"Data": "`)
+		for r.Context().Err() == nil {
+			if _, err := fmt.Fprint(w, strings.Repeat("x", 1024)); err != nil {
+				return
+			}
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:          "apiKey",
+		OpsGenieAPIURL:  ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		CoalesceGets:    true,
+		MaxResponseSize: 1024,
+	})
+	assert.Nil(t, err)
+
+	err = ogClient.Exec(context.Background(), &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	tooLarge, ok := err.(*ResponseTooLargeError)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1024), tooLarge.Limit)
+}
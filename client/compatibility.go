@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Compatibility opts into behavior changes that will become defaults in a future major version. Leaving
+// Config.Compatibility nil keeps the legacy behavior. Setting it enables all changes, except those whose legacy
+// behavior is kept by the corresponding flag.
+type Compatibility struct {
+	// LegacyErrorStrings keeps the verbose "Error occurred with Status code: ..." messages of ApiError instead of
+	// the shorter "<status> <error type>: <message> (request id: <id>)" form.
+	LegacyErrorStrings bool
+
+	// LegacyNoContext keeps executing requests with a background context when a nil context is given, instead of
+	// returning an error.
+	LegacyNoContext bool
+}
+
+var legacyCompatibility = Compatibility{
+	LegacyErrorStrings: true,
+	LegacyNoContext:    true,
+}
+
+func (cli *OpsGenieClient) compatibility() Compatibility {
+	if cli.Config.Compatibility == nil {
+		return legacyCompatibility
+	}
+	return *cli.Config.Compatibility
+}
+
+// DefaultContext returns ctx, or for a nil ctx a background context or an error, by Compatibility.LegacyNoContext.
+// Context-aware helpers outside this package use it to treat nil contexts like the client does.
+func (cli *OpsGenieClient) DefaultContext(ctx context.Context) (context.Context, error) {
+	return cli.defaultContext(ctx)
+}
+
+func (cli *OpsGenieClient) defaultContext(ctx context.Context) (context.Context, error) {
+	if ctx != nil {
+		return ctx, nil
+	}
+	if !cli.compatibility().LegacyNoContext {
+		return nil, errors.New("Context cannot be nil.")
+	}
+	return context.Background(), nil
+}
+
+func (ar *ApiError) shortError() string {
+	message := fmt.Sprintf("%d", ar.StatusCode)
+	if ar.ErrorHeader != "" {
+		message += " " + ar.ErrorHeader
+	}
+	message += ": " + ar.Message
+	if len(ar.Errors) > 0 {
+		message += fmt.Sprintf(" %v", ar.Errors)
+	}
+	if ar.RequestId != "" {
+		message += " (request id: " + ar.RequestId + ")"
+	}
+	return message
+}
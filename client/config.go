@@ -1,32 +1,159 @@
 package client
 
 import (
+	"bufio"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
 	ApiKey string
 
+	// ApiKeys, when set, is used instead of ApiKey. Requests are sent with the first key until one is rejected with
+	// 401 or 403, or still throttled with 429 after all retries; the request is then retried and later requests are
+	// sent with the next key. HttpMetric.KeyIndex tells which key was used.
+	ApiKeys []string
+
+	// CredentialsProvider, when set, is asked for the API key of every request instead of using ApiKey.
+	CredentialsProvider CredentialsProvider
+
 	OpsGenieAPIURL ApiUrl
 
+	Region Region
+
 	apiUrl string
 
 	ProxyConfiguration *ProxyConfiguration
 
+	TLSConfiguration *TLSConfiguration
+
+	// DNSConfiguration sets a custom resolver or pins hosts to addresses. Like proxy and TLS configurations it can
+	// only be combined with an *http.Transport.
+	DNSConfiguration *DNSConfiguration
+
+	// MaxIdleConns, MaxIdleConnsPerHost and IdleConnTimeout tune the connection pool of the transport, zero values
+	// keep the transport defaults. ForceHTTP2 attempts HTTP/2 although the transport uses a custom dialer,
+	// it requires Go 1.13 or later.
+	// Like proxy and TLS configurations they can only be combined with an *http.Transport.
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	ForceHTTP2          bool
+
 	RequestTimeout time.Duration
 
+	// RedirectPolicy controls how redirects are followed. Without it the http client follows up to 10 redirects
+	// and drops the Authorization header on redirects to other domains. See WithRedirectPolicy for setting it per
+	// request.
+	RedirectPolicy *RedirectPolicy
+
+	// EndpointProfiles override timeouts and retries for the resource paths they match, the first matching
+	// profile applies.
+	EndpointProfiles []EndpointProfile
+
+	MaxResponseSize int64
+
+	// ResponseCache, when set, is used to send conditional GET requests and serve unchanged resources from it.
+	ResponseCache *ResponseCache
+
+	// CoalesceGets makes identical GET requests executed concurrently share a single request and its response.
+	// See WithCoalescing for enabling it per request.
+	CoalesceGets bool
+
+	// DiskCache, when set, serves GET requests to configuration endpoints from files while they are fresh.
+	// See NewDiskCache.
+	DiskCache *DiskCache
+
 	HttpClient *http.Client
 
+	// Transport, when set, replaces the transport of the http client used to send requests.
+	// Proxy and TLS configurations can only be combined with an *http.Transport.
+	Transport http.RoundTripper
+
 	Backoff retryablehttp.Backoff
 
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries. They default to 1 and 30 seconds.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// Jitter randomizes the backoff between retries. It is ignored when Backoff is set.
+	Jitter JitterStrategy
+
+	// MaxElapsedTime bounds the whole Exec call, retries and backoff included, even when the context has a later
+	// deadline. Retries whose backoff would exceed it are not attempted.
+	MaxElapsedTime time.Duration
+
 	RetryPolicy retryablehttp.CheckRetry
 
 	RetryCount int
 
+	RetryEventHandler RetryEventHandler
+
+	// Hooks are called at the start of every attempt, before retries and on every response.
+	Hooks Hooks
+
+	// ErrorBudgetWindow is the sliding window of the error budgets reported by Health. Defaults to 5 minutes.
+	ErrorBudgetWindow time.Duration
+
+	// AttemptSummaries is the number of attempts summarized on errors returned after retries are exhausted.
+	// Defaults to 5.
+	AttemptSummaries int
+
+	// IdempotencyKeys enables sending a generated Idempotency-Key header with every write request,
+	// so retried requests can be recognized as duplicates. See WithIdempotencyKey for setting the key per request.
+	IdempotencyKeys bool
+
+	// IdGenerator generates transaction ids and idempotency keys. NewULID is used when it is not set.
+	IdGenerator IdGenerator
+
+	Serializer Serializer
+
+	// UserAgent is appended to the User-Agent header of the SDK, e.g. "my-alert-router/1.4".
+	UserAgent string
+
+	// Actor is the default user and source of actions whose requests leave them empty. See WithActor.
+	Actor Actor
+
+	// DebugHttp logs every request and response, including headers and bodies, at debug level. The Authorization
+	// header and API keys in bodies are redacted.
+	DebugHttp bool
+
+	// Compatibility opts into behavior changes planned for the next major version. See Compatibility.
+	Compatibility *Compatibility
+
+	// Clock is used for backoff waits, polling and durations of the client and the helpers built on it.
+	// SystemClock is used when it is not set.
+	Clock Clock
+
+	// DryRun validates and builds requests and logs them at info level without sending them. Results only carry
+	// a request id starting with DryRunRequestIdPrefix. See WithDryRun for enabling it per request.
+	DryRun bool
+
+	// HedgeDelay, when set, sends a second GET request if no response arrived within the delay and uses whichever
+	// response arrives first. See WithHedgeDelay for setting it per request.
+	HedgeDelay time.Duration
+
+	// IgnoreNotFoundOnRemoval treats 404 responses to DELETE requests and other RemovalRequests, such as closing
+	// alerts, as success and sets AlreadyAbsent on their results. See WithIgnoreNotFoundOnRemoval for enabling it
+	// per request.
+	IgnoreNotFoundOnRemoval bool
+
+	// MetricPublishing, when set, makes the client queue its metrics and deliver them to subscribers from a worker.
+	MetricPublishing *MetricPublishing
+
+	// AuditOnStartup logs a warning for every risky setting found by Audit when the client is created.
+	AuditOnStartup bool
+
+	// Account names the Opsgenie account the client belongs to, e.g. "prod". See AccountFromContext.
+	Account string
+
 	LogLevel logrus.Level
 
 	Logger *logrus.Logger
@@ -40,14 +167,76 @@ const (
 	API_URL_SANDBOX ApiUrl = "api.sandbox.opsgenie.com"
 )
 
+type Region string
+
+const (
+	RegionUS      Region = "us"
+	RegionEU      Region = "eu"
+	RegionSandbox Region = "sandbox"
+)
+
+var regionUrls = map[Region]ApiUrl{
+	RegionUS:      API_URL,
+	RegionEU:      API_URL_EU,
+	RegionSandbox: API_URL_SANDBOX,
+}
+
+// ApiUrl returns the API url of the region.
+func (r Region) ApiUrl() ApiUrl {
+	return regionUrls[r]
+}
+
 func (conf Config) Validate() error {
 
-	if conf.ApiKey == "" {
+	if conf.ApiKey == "" && len(conf.ApiKeys) == 0 && conf.CredentialsProvider == nil {
 		return errors.New("API key cannot be blank.")
 	}
+	for _, key := range conf.ApiKeys {
+		if key == "" {
+			return errors.New("API keys cannot contain a blank key.")
+		}
+	}
 	if conf.RetryCount < 0 {
 		return errors.New("Retry count cannot be less than 1.")
 	}
+	if conf.RetryWaitMin < 0 || conf.RetryWaitMax < 0 || conf.MaxElapsedTime < 0 {
+		return errors.New("Retry wait times and max elapsed time cannot be negative.")
+	}
+	if conf.RetryWaitMin > 0 && conf.RetryWaitMax > 0 && conf.RetryWaitMin > conf.RetryWaitMax {
+		return errors.New("Retry wait min cannot be greater than retry wait max.")
+	}
+	if conf.DiskCache != nil {
+		if err := conf.DiskCache.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, profile := range conf.EndpointProfiles {
+		if err := profile.Validate(); err != nil {
+			return err
+		}
+	}
+	if conf.DNSConfiguration != nil {
+		if err := conf.DNSConfiguration.Validate(); err != nil {
+			return err
+		}
+	}
+	if conf.ProxyConfiguration != nil {
+		if err := conf.ProxyConfiguration.Validate(); err != nil {
+			return err
+		}
+	}
+	if conf.MaxIdleConns < 0 || conf.MaxIdleConnsPerHost < 0 || conf.IdleConnTimeout < 0 {
+		return errors.New("Connection pool settings cannot be negative.")
+	}
+	if conf.MaxResponseSize < 0 {
+		return errors.New("Max response size cannot be negative.")
+	}
+	if _, ok := regionUrls[conf.Region]; conf.Region != "" && !ok {
+		return errors.New("Region should be one of us, eu or sandbox.")
+	}
+	if strings.ContainsAny(conf.UserAgent, "\r\n") {
+		return errors.New("User agent cannot contain line breaks.")
+	}
 	return nil
 }
 
@@ -55,12 +244,47 @@ func Default() *Config {
 	return &Config{}
 }
 
+// ProxyConfiguration routes requests through an HTTP(S) or SOCKS5 proxy. Username and Password are sent as basic
+// auth to HTTP proxies, including for CONNECT tunnels, and as SOCKS5 username/password authentication.
+// ProxyFunc, when set, takes precedence over the other fields and selects the proxy per request.
 type ProxyConfiguration struct {
-	Username string
-	Password string
-	Host     string
-	Protocol Protocol
-	Port     int
+	Username  string
+	Password  string
+	Host      string
+	Protocol  Protocol
+	Port      int
+	ProxyFunc func(*http.Request) (*url.URL, error)
+}
+
+func (pc *ProxyConfiguration) Validate() error {
+	if pc.ProxyFunc != nil {
+		return nil
+	}
+	if pc.Host == "" {
+		return errors.New("Proxy host cannot be empty.")
+	}
+	if pc.Protocol != Http && pc.Protocol != Https && pc.Protocol != Socks5 {
+		return errors.New("Proxy protocol should be one of http, https or socks5.")
+	}
+	if pc.Password != "" && pc.Username == "" {
+		return errors.New("Proxy username cannot be empty when password is set.")
+	}
+	return nil
+}
+
+func (pc *ProxyConfiguration) url() *url.URL {
+	proxy := pc.Host
+	if pc.Port != 0 {
+		proxy = proxy + ":" + strconv.Itoa(pc.Port)
+	}
+	proxyUrl := &url.URL{
+		Host:   proxy,
+		Scheme: string(pc.Protocol),
+	}
+	if pc.Username != "" {
+		proxyUrl.User = url.UserPassword(pc.Username, pc.Password)
+	}
+	return proxyUrl
 }
 
 type Protocol string
@@ -93,3 +317,113 @@ func (conf *Config) ConfigureLogLevel(level string) {
 	}
 	conf.LogLevel = logLevel
 }
+
+const (
+	EnvApiKey         = "OPSGENIE_API_KEY"
+	EnvApiUrl         = "OPSGENIE_API_URL"
+	EnvRegion         = "OPSGENIE_REGION"
+	EnvProxyHost      = "OPSGENIE_PROXY_HOST"
+	EnvProxyPort      = "OPSGENIE_PROXY_PORT"
+	EnvProxyProtocol  = "OPSGENIE_PROXY_PROTOCOL"
+	EnvProxyUsername  = "OPSGENIE_PROXY_USERNAME"
+	EnvProxyPassword  = "OPSGENIE_PROXY_PASSWORD"
+	EnvRetryCount     = "OPSGENIE_RETRY_COUNT"
+	EnvRequestTimeout = "OPSGENIE_REQUEST_TIMEOUT"
+	EnvLogLevel       = "OPSGENIE_LOG_LEVEL"
+	EnvUserAgent      = "OPSGENIE_USER_AGENT"
+)
+
+// ConfigFromEnv builds a Config from the OPSGENIE_* environment variables.
+func ConfigFromEnv() (*Config, error) {
+	return configFromLookup(os.LookupEnv)
+}
+
+// ConfigFromFile builds a Config from a file of KEY=VALUE lines using the same keys as ConfigFromEnv.
+// Blank lines and lines starting with # are ignored. Values set in the environment take precedence.
+func ConfigFromFile(path string) (*Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("Invalid line %d in %s, expected KEY=VALUE.", lineNumber, path)
+		}
+		values[strings.TrimSpace(parts[0])] = strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return configFromLookup(func(key string) (string, bool) {
+		if value, ok := os.LookupEnv(key); ok {
+			return value, true
+		}
+		value, ok := values[key]
+		return value, ok
+	})
+}
+
+func configFromLookup(lookup func(key string) (string, bool)) (*Config, error) {
+	conf := Default()
+	conf.ApiKey, _ = lookup(EnvApiKey)
+
+	if region, ok := lookup(EnvRegion); ok {
+		conf.Region = Region(strings.ToLower(region))
+	}
+	if apiUrl, ok := lookup(EnvApiUrl); ok {
+		conf.OpsGenieAPIURL = ApiUrl(apiUrl)
+	}
+
+	if host, ok := lookup(EnvProxyHost); ok {
+		conf.ProxyConfiguration = &ProxyConfiguration{Host: host, Protocol: Http}
+		if port, ok := lookup(EnvProxyPort); ok {
+			portNumber, err := strconv.Atoi(port)
+			if err != nil {
+				return nil, errors.Errorf("%s should be a number.", EnvProxyPort)
+			}
+			conf.ProxyConfiguration.Port = portNumber
+		}
+		if protocol, ok := lookup(EnvProxyProtocol); ok {
+			conf.ProxyConfiguration.Protocol = Protocol(strings.ToLower(protocol))
+		}
+		conf.ProxyConfiguration.Username, _ = lookup(EnvProxyUsername)
+		conf.ProxyConfiguration.Password, _ = lookup(EnvProxyPassword)
+	}
+
+	if retryCount, ok := lookup(EnvRetryCount); ok {
+		count, err := strconv.Atoi(retryCount)
+		if err != nil {
+			return nil, errors.Errorf("%s should be a number.", EnvRetryCount)
+		}
+		conf.RetryCount = count
+	}
+
+	if timeout, ok := lookup(EnvRequestTimeout); ok {
+		requestTimeout, err := time.ParseDuration(timeout)
+		if err != nil {
+			return nil, errors.Errorf("%s should be a duration such as 30s.", EnvRequestTimeout)
+		}
+		conf.RequestTimeout = requestTimeout
+	}
+
+	if level, ok := lookup(EnvLogLevel); ok {
+		conf.ConfigureLogLevel(strings.ToLower(level))
+	}
+
+	conf.UserAgent, _ = lookup(EnvUserAgent)
+
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
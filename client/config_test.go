@@ -1,8 +1,12 @@
 package client
 
 import (
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 )
 
 func TestValidateApiKey(t *testing.T) {
@@ -17,3 +21,56 @@ func TestValidateRetryCount(t *testing.T) {
 	err := conf.Validate()
 	assert.Contains(t, err.Error(), "cannot be less than 1")
 }
+
+func TestConfigFromEnv(t *testing.T) {
+	os.Setenv(EnvApiKey, "env-key")
+	os.Setenv(EnvRegion, "EU")
+	os.Setenv(EnvProxyHost, "proxy.local")
+	os.Setenv(EnvProxyPort, "3128")
+	os.Setenv(EnvRetryCount, "2")
+	os.Setenv(EnvRequestTimeout, "5s")
+	os.Setenv(EnvLogLevel, "debug")
+	defer func() {
+		for _, key := range []string{EnvApiKey, EnvRegion, EnvProxyHost, EnvProxyPort, EnvRetryCount, EnvRequestTimeout, EnvLogLevel} {
+			os.Unsetenv(key)
+		}
+	}()
+
+	conf, err := ConfigFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, "env-key", conf.ApiKey)
+	assert.Equal(t, RegionEU, conf.Region)
+	assert.Equal(t, &ProxyConfiguration{Host: "proxy.local", Port: 3128, Protocol: Http}, conf.ProxyConfiguration)
+	assert.Equal(t, 2, conf.RetryCount)
+	assert.Equal(t, 5*time.Second, conf.RequestTimeout)
+	assert.Equal(t, logrus.DebugLevel, conf.LogLevel)
+
+	os.Setenv(EnvRetryCount, "two")
+	_, err = ConfigFromEnv()
+	assert.EqualError(t, err, "OPSGENIE_RETRY_COUNT should be a number.")
+}
+
+func TestConfigFromFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "opsgenie-config")
+	assert.Nil(t, err)
+	defer os.Remove(file.Name())
+	file.WriteString("# opsgenie\nOPSGENIE_API_KEY=\"file-key\"\n\nOPSGENIE_API_URL=api.sandbox.opsgenie.com\n")
+	file.Close()
+
+	conf, err := ConfigFromFile(file.Name())
+	assert.Nil(t, err)
+	assert.Equal(t, "file-key", conf.ApiKey)
+	assert.Equal(t, API_URL_SANDBOX, conf.OpsGenieAPIURL)
+
+	_, err = ConfigFromFile(file.Name() + "-missing")
+	assert.NotNil(t, err)
+}
+
+func TestValidateRegion(t *testing.T) {
+	conf := &Config{ApiKey: "an api key", Region: "asia"}
+	assert.EqualError(t, conf.Validate(), "Region should be one of us, eu or sandbox.")
+
+	conf.Region = RegionEU
+	assert.Nil(t, conf.Validate())
+	assert.Equal(t, API_URL_EU, conf.Region.ApiUrl())
+}
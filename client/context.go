@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// detachedContext carries the values of its parent but not its deadline and cancellation, like
+// context.WithoutCancel of Go 1.21.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+// WithoutCancel returns a context that carries the values of ctx, such as its headers and actor, but is not done
+// when ctx is. It lets cleanups, like rolling back what a cancelled call created, run with the same settings.
+func WithoutCancel(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
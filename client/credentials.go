@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CredentialsProvider returns the API key to authenticate a request with. It is called for every request, so keys
+// rotated by the provider are picked up without recreating the client.
+type CredentialsProvider interface {
+	GetKey(ctx context.Context) (string, error)
+}
+
+// StaticCredentials always returns the same API key. It is used for Config.ApiKey when no provider is configured.
+type StaticCredentials string
+
+func (c StaticCredentials) GetKey(ctx context.Context) (string, error) {
+	return string(c), nil
+}
+
+func (cli *OpsGenieClient) credentialsProvider() CredentialsProvider {
+	if cli.Config.CredentialsProvider != nil {
+		return cli.Config.CredentialsProvider
+	}
+	return StaticCredentials(cli.Config.ApiKey)
+}
+
+func (cli *OpsGenieClient) setAuthorization(ctx context.Context, req *request) error {
+	if len(cli.Config.ApiKeys) > 0 && cli.Config.CredentialsProvider == nil {
+		key, index := cli.currentApiKey()
+		req.keyIndex = index
+		req.Header.Set("Authorization", "GenieKey "+key)
+		return nil
+	}
+	key, err := cli.credentialsProvider().GetKey(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Could not get API key")
+	}
+	if key == "" {
+		return errors.New("API key cannot be blank.")
+	}
+	if cli.Config.CredentialsProvider != nil {
+		cli.providedKeys.add(key)
+	}
+	req.Header.Set("Authorization", "GenieKey "+key)
+	return nil
+}
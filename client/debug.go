@@ -0,0 +1,76 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const redacted = "***"
+
+var (
+	authorizationPattern = regexp.MustCompile(`(?i)(Authorization:\s*(?:GenieKey\s+)?)\S+`)
+	apiKeyFieldPattern   = regexp.MustCompile(`(?i)("api_?key"\s*:\s*")[^"]*"`)
+)
+
+// providedKeys holds the keys returned by Config.CredentialsProvider, so they are redacted like the configured ones.
+type providedKeys struct {
+	mux  sync.Mutex
+	keys map[string]struct{}
+}
+
+func (k *providedKeys) add(key string) {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+	if k.keys == nil {
+		k.keys = make(map[string]struct{})
+	}
+	k.keys[key] = struct{}{}
+}
+
+func (k *providedKeys) list() []string {
+	k.mux.Lock()
+	defer k.mux.Unlock()
+	keys := make([]string, 0, len(k.keys))
+	for key := range k.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// redact masks the Authorization header, apiKey fields of JSON bodies and any occurrence of the configured API keys
+// or of the keys returned by the credentials provider.
+func (cli *OpsGenieClient) redact(dump []byte) string {
+	s := authorizationPattern.ReplaceAllString(string(dump), "${1}"+redacted)
+	s = apiKeyFieldPattern.ReplaceAllString(s, "${1}"+redacted+`"`)
+	if cli.Config.ApiKey != "" {
+		s = strings.Replace(s, cli.Config.ApiKey, redacted, -1)
+	}
+	for _, key := range cli.Config.ApiKeys {
+		s = strings.Replace(s, key, redacted, -1)
+	}
+	for _, key := range cli.providedKeys.list() {
+		s = strings.Replace(s, key, redacted, -1)
+	}
+	return s
+}
+
+func (cli *OpsGenieClient) dumpRequest(req *http.Request) {
+	dump, err := httputil.DumpRequestOut(req, true)
+	if err != nil {
+		cli.Config.Logger.Debugf("Could not dump request: %s", err.Error())
+		return
+	}
+	cli.Config.Logger.Debugf("Sending request:\n%s", cli.redact(dump))
+}
+
+func (cli *OpsGenieClient) dumpResponse(response *http.Response) {
+	dump, err := httputil.DumpResponse(response, true)
+	if err != nil {
+		cli.Config.Logger.Debugf("Could not dump response: %s", err.Error())
+		return
+	}
+	cli.Config.Logger.Debugf("Received response:\n%s", cli.redact(dump))
+}
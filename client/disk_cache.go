@@ -0,0 +1,159 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultDiskCachePaths are the configuration endpoints cached by a DiskCache without Paths.
+var DefaultDiskCachePaths = []string{"/v2/teams", "/v2/schedules", "/v2/escalations"}
+
+// DiskCache stores successful GET responses of configuration endpoints in files, so short lived processes such as
+// CLI tools can share them across invocations. Unlike ResponseCache, responses younger than TTL are served without
+// sending a request. Entries are keyed by the API key, resource path and query. Changes made through other clients
+// are only seen once entries expire.
+type DiskCache struct {
+	Dir string
+	TTL time.Duration
+	// Paths are the resource path prefixes whose responses are cached. Defaults to DefaultDiskCachePaths.
+	Paths []string
+}
+
+type diskCacheEntry struct {
+	StoredAt time.Time   `json:"storedAt"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+}
+
+// NewDiskCache returns a cache keeping entries for ttl in dir, or in the opsgenie-go-sdk directory of the user
+// cache directory when dir is empty.
+func NewDiskCache(dir string, ttl time.Duration) (*DiskCache, error) {
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not find the user cache directory")
+		}
+		dir = filepath.Join(cacheDir, "opsgenie-go-sdk")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "Could not create the cache directory")
+	}
+	return &DiskCache{Dir: dir, TTL: ttl}, nil
+}
+
+func (c *DiskCache) Validate() error {
+	if c.Dir == "" {
+		return errors.New("Disk cache directory cannot be empty.")
+	}
+	if c.TTL <= 0 {
+		return errors.New("Disk cache TTL should be positive.")
+	}
+	return nil
+}
+
+// Clear removes all entries of the cache.
+func (c *DiskCache) Clear() error {
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *DiskCache) caches(req *request) bool {
+	if c == nil || req.Method != http.MethodGet {
+		return false
+	}
+	paths := c.Paths
+	if len(paths) == 0 {
+		paths = DefaultDiskCachePaths
+	}
+	for _, prefix := range paths {
+		if strings.HasPrefix(req.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// file returns the file of a request's entry. The API key is part of the key so clients of different accounts
+// sharing a directory never see each other's entries.
+func (c *DiskCache) file(req *request) string {
+	hash := sha256.Sum256([]byte(req.Header.Get("Authorization") + "\n" + req.URL.Path + "?" + req.URL.RawQuery))
+	return filepath.Join(c.Dir, hex.EncodeToString(hash[:])+".json")
+}
+
+// lookup returns a response built from the entry of the request if it has not expired yet. Unreadable entries are
+// treated as missing.
+func (c *DiskCache) lookup(req *request, now time.Time) (*http.Response, bool) {
+	if !c.caches(req) {
+		return nil, false
+	}
+	content, err := ioutil.ReadFile(c.file(req))
+	if err != nil {
+		return nil, false
+	}
+	entry := &diskCacheEntry{}
+	if err := json.Unmarshal(content, entry); err != nil || now.Sub(entry.StoredAt) >= c.TTL {
+		return nil, false
+	}
+	return &http.Response{
+		Status:        http.StatusText(http.StatusOK),
+		StatusCode:    http.StatusOK,
+		Header:        entry.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req.Request.Request,
+	}, true
+}
+
+// store writes successful responses of cached endpoints to a new file, which replaces the previous entry once it
+// is complete. The response body is buffered so it can still be parsed.
+func (c *DiskCache) store(req *request, response *http.Response, now time.Time) error {
+	if !c.caches(req) || response.StatusCode != http.StatusOK {
+		return nil
+	}
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := cacheableHeader(response.Header)
+	// The body was already decoded to UTF-8.
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	content, err := json.Marshal(&diskCacheEntry{StoredAt: now, Header: header, Body: body})
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(c.Dir, "entry-*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.file(req))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTeamsRequest struct {
+	testGetRequest
+}
+
+func (r testTeamsRequest) ResourcePath() string {
+	return "/v2/teams"
+}
+
+func TestDiskCache(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "rId")
+		fmt.Fprintf(w, `{"Data": "teams-%d", "took": 1, "requestId": "rId"}`, requests)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "disk-cache")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	diskCache, err := NewDiskCache(dir, time.Hour)
+	assert.Nil(t, err)
+
+	clock := NewManualClock(time.Date(2019, 12, 24, 12, 0, 0, 0, time.UTC))
+	newClient := func(apiKey string) *OpsGenieClient {
+		ogClient, err := NewOpsGenieClient(&Config{
+			ApiKey:         apiKey,
+			OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+			DiskCache:      diskCache,
+			Clock:          clock,
+		})
+		assert.Nil(t, err)
+		return ogClient
+	}
+
+	result := &testResult{}
+	err = newClient("apiKey").Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "teams-1", result.Data)
+
+	result = &testResult{}
+	err = newClient("apiKey").Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "teams-1", result.Data)
+	assert.Equal(t, "rId", result.RequestId)
+	assert.Equal(t, 1, requests)
+
+	result = &testResult{}
+	err = newClient("anotherApiKey").Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "teams-2", result.Data)
+
+	err = newClient("apiKey").Exec(nil, &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, requests)
+
+	clock.Advance(time.Hour)
+	result = &testResult{}
+	err = newClient("apiKey").Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "teams-4", result.Data)
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	assert.Equal(t, 2, len(files))
+	assert.Nil(t, diskCache.Clear())
+	files, _ = filepath.Glob(filepath.Join(dir, "*"))
+	assert.Equal(t, 0, len(files))
+}
+
+func TestDiskCacheDoesNotStoreRetries(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"Data": "teams", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "disk-cache")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	diskCache, err := NewDiskCache(dir, time.Hour)
+	assert.Nil(t, err)
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		DiskCache:      diskCache,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.RetryCount)
+	assert.Equal(t, 1, result.ThrottledCount)
+
+	result = &testResult{}
+	err = ogClient.Exec(nil, &testTeamsRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "teams", result.Data)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 0, result.RetryCount)
+	assert.Equal(t, 0, result.ThrottledCount)
+}
+
+func TestDiskCacheValidate(t *testing.T) {
+	err := Config{ApiKey: "apiKey", DiskCache: &DiskCache{}}.Validate()
+	assert.Equal(t, "Disk cache directory cannot be empty.", err.Error())
+
+	err = Config{ApiKey: "apiKey", DiskCache: &DiskCache{Dir: "/tmp"}}.Validate()
+	assert.Equal(t, "Disk cache TTL should be positive.", err.Error())
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DNSConfiguration changes how the hosts of requests are resolved, for networks where DNS of external hosts is
+// restricted. StaticHosts pins hosts to addresses, e.g. "api.opsgenie.com" to "10.0.0.1" or "10.0.0.2:8443";
+// addresses without a port use the port of the request and are tried in order. Other hosts are resolved with
+// Resolver, or the default resolver when it is not set. When a proxy is configured, the proxy host is the one
+// resolved. TLS certificates are still verified against the original host.
+type DNSConfiguration struct {
+	Resolver    *net.Resolver
+	StaticHosts map[string][]string
+}
+
+func (dc *DNSConfiguration) Validate() error {
+	for host, addresses := range dc.StaticHosts {
+		if host == "" {
+			return errors.New("Static host cannot be empty.")
+		}
+		if len(addresses) == 0 {
+			return errors.Errorf("Addresses of static host %s cannot be empty.", host)
+		}
+		for _, address := range addresses {
+			if _, _, err := net.SplitHostPort(address); err != nil && net.ParseIP(address) == nil {
+				return errors.Errorf("Address %s of static host %s should be an IP or host:port.", address, host)
+			}
+		}
+	}
+	return nil
+}
+
+// dialContext returns a dial function that dials pinned hosts at their addresses and resolves other hosts with the
+// resolver, dialing through dial when no resolver is configured.
+func (dc *DNSConfiguration) dialContext(dial func(ctx context.Context, network, address string) (net.Conn, error)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	if dc.Resolver != nil || dial == nil {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Resolver:  dc.Resolver,
+		}
+		dial = dialer.DialContext
+	}
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		addresses, ok := dc.StaticHosts[host]
+		if !ok {
+			return dial(ctx, network, address)
+		}
+		var lastErr error
+		for _, pinned := range addresses {
+			if net.ParseIP(pinned) != nil {
+				pinned = net.JoinHostPort(pinned, port)
+			}
+			conn, err := dial(ctx, network, pinned)
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		return nil, errors.Wrapf(lastErr, "Could not dial any address of %s", host)
+	}
+}
+
+func setDNSSettings(cli *OpsGenieClient) error {
+	t, err := transport(cli)
+	if err != nil {
+		return err
+	}
+	t.DialContext = cli.Config.DNSConfiguration.dialContext(t.DialContext)
+	return nil
+}
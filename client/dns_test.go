@@ -0,0 +1,50 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSConfigurationStaticHosts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Host, "opsgenie.internal:"))
+		fmt.Fprint(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(ts.URL, "http://"))
+
+	// Nothing listens on the first address, so the second one is dialed.
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	closedAddress := closed.Addr().String()
+	closed.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl("opsgenie.internal:" + port),
+		RetryCount:     1,
+		DNSConfiguration: &DNSConfiguration{
+			StaticHosts: map[string][]string{"opsgenie.internal": {closedAddress, "127.0.0.1"}},
+		},
+	})
+	assert.Nil(t, err)
+
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "processed", result.Data)
+}
+
+func TestDNSConfigurationValidate(t *testing.T) {
+	err := (&DNSConfiguration{StaticHosts: map[string][]string{"api.opsgenie.com": nil}}).Validate()
+	assert.Equal(t, "Addresses of static host api.opsgenie.com cannot be empty.", err.Error())
+	err = (&DNSConfiguration{StaticHosts: map[string][]string{"api.opsgenie.com": {"not an ip"}}}).Validate()
+	assert.Equal(t, "Address not an ip of static host api.opsgenie.com should be an IP or host:port.", err.Error())
+	assert.Nil(t, (&DNSConfiguration{StaticHosts: map[string][]string{"api.opsgenie.com": {"10.0.0.1", "[::1]:8443"}}}).Validate())
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+)
+
+const DryRunRequestIdPrefix = "dry-run-"
+
+type dryRunContextKey struct{}
+
+// WithDryRun returns a context that overrides Config.DryRun for the requests executed with it.
+func WithDryRun(ctx context.Context, dryRun bool) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, dryRun)
+}
+
+func (cli *OpsGenieClient) isDryRun(ctx context.Context) bool {
+	if dryRun, ok := ctx.Value(dryRunContextKey{}).(bool); ok {
+		return dryRun
+	}
+	return cli.Config.DryRun
+}
+
+// dryRun logs the request that would have been sent and returns a synthetic empty response in its place.
+func (cli *OpsGenieClient) dryRun(req *request, transactionId string) (*http.Response, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+	dump, err := httputil.DumpRequestOut(req.Request.Request, true)
+	if err != nil {
+		return nil, err
+	}
+	cli.Config.Logger.Infof("Dry run, not sending request:\n%s", cli.redact(dump))
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	header.Set("X-Request-Id", DryRunRequestIdPrefix+transactionId)
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+		Request:    req.Request.Request,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// decodedBody reads the decoded body and closes the original one.
+type decodedBody struct {
+	io.Reader
+	body io.Closer
+}
+
+func (b *decodedBody) Close() error {
+	return b.body.Close()
+}
+
+// decodeContentEncoding decompresses gzip and deflate encoded responses. The http transport only does so for
+// requests it asked compression for, while some proxies compress responses regardless.
+func decodeContentEncoding(response *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(response.Header.Get("Content-Encoding")))
+	var reader io.Reader
+	switch encoding {
+	case "", "identity":
+		return nil
+	case "gzip", "x-gzip":
+		gzipReader, err := gzip.NewReader(response.Body)
+		if err != nil {
+			return errors.Wrap(err, "Could not decode gzip response")
+		}
+		reader = gzipReader
+	case "deflate":
+		// Deflate is meant to be zlib wrapped, but raw deflate streams are common.
+		buffered := bufio.NewReader(response.Body)
+		header, _ := buffered.Peek(2)
+		if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+			zlibReader, err := zlib.NewReader(buffered)
+			if err != nil {
+				return errors.Wrap(err, "Could not decode deflate response")
+			}
+			reader = zlibReader
+		} else {
+			reader = flate.NewReader(buffered)
+		}
+	default:
+		return errors.Errorf("Response content encoding %s is not supported.", encoding)
+	}
+	response.Body = &decodedBody{Reader: reader, body: response.Body}
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = -1
+	response.Uncompressed = true
+	return nil
+}
+
+// normalizeCharset converts bodies declared in ISO-8859-1, Windows-1252 or UTF-16 to UTF-8 and drops byte order
+// marks, which the JSON decoder rejects. Bodies in other charsets are left as they are.
+func normalizeCharset(response *http.Response) error {
+	charset := ""
+	if _, params, err := mime.ParseMediaType(response.Header.Get("Content-Type")); err == nil {
+		charset = strings.ToLower(params["charset"])
+	}
+	switch charset {
+	case "", "utf-8", "utf8":
+		buffered := bufio.NewReader(response.Body)
+		if bom, _ := buffered.Peek(3); bytes.Equal(bom, []byte{0xef, 0xbb, 0xbf}) {
+			buffered.Discard(3)
+		}
+		response.Body = &decodedBody{Reader: buffered, body: response.Body}
+		return nil
+	case "iso-8859-1", "latin1", "us-ascii", "windows-1252", "cp1252", "utf-16", "utf-16le", "utf-16be":
+	default:
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	var decoded []byte
+	if strings.HasPrefix(charset, "utf-16") {
+		decoded = decodeUTF16(body, charset == "utf-16le")
+	} else {
+		decoded = decodeSingleByte(body, strings.Contains(charset, "1252"))
+	}
+	response.Body = &decodedBody{Reader: bytes.NewReader(decoded), body: response.Body}
+	return nil
+}
+
+// windows1252 maps the bytes 0x80-0x9f, where Windows-1252 differs from ISO-8859-1.
+var windows1252 = [32]rune{
+	'€', 0x81, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0x8d, 'Ž', 0x8f,
+	0x90, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0x9d, 'ž', 'Ÿ',
+}
+
+func decodeSingleByte(body []byte, cp1252 bool) []byte {
+	decoded := make([]byte, 0, len(body))
+	buf := make([]byte, utf8.UTFMax)
+	for _, b := range body {
+		r := rune(b)
+		if cp1252 && b >= 0x80 && b <= 0x9f {
+			r = windows1252[b-0x80]
+		}
+		n := utf8.EncodeRune(buf, r)
+		decoded = append(decoded, buf[:n]...)
+	}
+	return decoded
+}
+
+// decodeUTF16 decodes big endian unless littleEndian is set or the body starts with a little endian byte order mark.
+func decodeUTF16(body []byte, littleEndian bool) []byte {
+	if len(body) >= 2 {
+		if body[0] == 0xff && body[1] == 0xfe {
+			littleEndian, body = true, body[2:]
+		} else if body[0] == 0xfe && body[1] == 0xff {
+			littleEndian, body = false, body[2:]
+		}
+	}
+	units := make([]uint16, len(body)/2)
+	for i := range units {
+		if littleEndian {
+			units[i] = uint16(body[2*i]) | uint16(body[2*i+1])<<8
+		} else {
+			units[i] = uint16(body[2*i])<<8 | uint16(body[2*i+1])
+		}
+	}
+	return []byte(string(utf16.Decode(units)))
+}
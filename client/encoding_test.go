@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodedResponse(encoding string, body []byte) *http.Response {
+	return &http.Response{
+		Header:        http.Header{"Content-Encoding": []string{encoding}},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+}
+
+func TestDecodeContentEncoding(t *testing.T) {
+	payload := `{"data": "processed"}`
+	compress := map[string]func(w io.Writer) io.WriteCloser{
+		"gzip":    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"deflate": func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+		"raw": func(w io.Writer) io.WriteCloser {
+			writer, _ := flate.NewWriter(w, flate.DefaultCompression)
+			return writer
+		},
+	}
+	for name, newWriter := range compress {
+		buf := &bytes.Buffer{}
+		writer := newWriter(buf)
+		writer.Write([]byte(payload))
+		writer.Close()
+
+		encoding := name
+		if name == "raw" {
+			encoding = "deflate"
+		}
+		response := encodedResponse(encoding, buf.Bytes())
+		assert.Nil(t, decodeContentEncoding(response), name)
+		body, err := ioutil.ReadAll(response.Body)
+		assert.Nil(t, err, name)
+		assert.Equal(t, payload, string(body), name)
+		assert.Equal(t, int64(-1), response.ContentLength)
+		assert.Equal(t, "", response.Header.Get("Content-Encoding"))
+	}
+
+	err := decodeContentEncoding(encodedResponse("br", []byte(payload)))
+	assert.Equal(t, "Response content encoding br is not supported.", err.Error())
+}
+
+func TestNormalizeCharset(t *testing.T) {
+	cases := map[string][]byte{
+		"application/json; charset=ISO-8859-1":   {'"', 'c', 0xe9, '"'},
+		"application/json; charset=windows-1252": {'"', 0x80, 'c', 0xe9, '"'},
+		"application/json; charset=utf-16le":     {'"', 0, 'c', 0, 0xe9, 0, '"', 0},
+		"application/json; charset=utf-16":       {0xfe, 0xff, 0, '"', 0, 'c', 0, 0xe9, 0, '"'},
+		"application/json":                       {0xef, 0xbb, 0xbf, '"', 'c', 0xc3, 0xa9, '"'},
+	}
+	expected := map[string]string{
+		"application/json; charset=windows-1252": `"€cé"`,
+	}
+	for contentType, body := range cases {
+		response := &http.Response{
+			Header: http.Header{"Content-Type": []string{contentType}},
+			Body:   ioutil.NopCloser(bytes.NewReader(body)),
+		}
+		assert.Nil(t, normalizeCharset(response))
+		decoded, _ := ioutil.ReadAll(response.Body)
+		want, ok := expected[contentType]
+		if !ok {
+			want = `"cé"`
+		}
+		assert.Equal(t, want, string(decoded), contentType)
+	}
+}
+
+func TestExecDecodesResponses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := &bytes.Buffer{}
+		writer := zlib.NewWriter(buf)
+		writer.Write(append([]byte(`{"Data": "c`), 0xe9, '"', '}'))
+		writer.Close()
+		w.Header().Set("Content-Type", "application/json; charset=iso-8859-1")
+		w.Header().Set("Content-Encoding", "deflate")
+		w.Write(buf.Bytes())
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "cé", result.Data)
+}
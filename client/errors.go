@@ -0,0 +1,140 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const errorBodySnippetSize = 512
+
+// ResponseError is implemented by the errors returned when a response was received but could not be processed.
+// The request id should be included in support requests.
+type ResponseError interface {
+	error
+	GetRequestId() string
+	GetStatusCode() int
+	GetRateLimitState() string
+	// GetBody returns the beginning of the response body.
+	GetBody() string
+}
+
+func (ar *ApiError) GetRequestId() string {
+	return ar.RequestId
+}
+
+func (ar *ApiError) GetStatusCode() int {
+	return ar.StatusCode
+}
+
+func (ar *ApiError) GetRateLimitState() string {
+	return ar.RateLimitState
+}
+
+func (ar *ApiError) GetBody() string {
+	return ar.Body
+}
+
+// FieldError is a request field rejected by the API, usually with a 422 response.
+type FieldError struct {
+	// Path is the field path split into its segments, e.g. recipients#type becomes [recipients type].
+	Path    []string
+	Message string
+}
+
+// FieldErrors holds the field errors of a response keyed by the field path as sent by the API, e.g. recipients#type.
+type FieldErrors map[string]FieldError
+
+func newFieldErrors(errs map[string]string) FieldErrors {
+	if len(errs) == 0 {
+		return nil
+	}
+	fieldErrors := make(FieldErrors, len(errs))
+	for field, message := range errs {
+		fieldErrors[field] = FieldError{Path: strings.Split(field, "#"), Message: message}
+	}
+	return fieldErrors
+}
+
+// FieldErrorsOf returns the field errors of an ApiError, also when it is wrapped.
+func FieldErrorsOf(err error) (FieldErrors, bool) {
+	apiErr, ok := errors.Cause(err).(*ApiError)
+	if !ok || len(apiErr.FieldErrors) == 0 {
+		return nil, false
+	}
+	return apiErr.FieldErrors, true
+}
+
+// ParseError is returned when a successful response could not be parsed into the result.
+type ParseError struct {
+	RequestId      string
+	StatusCode     int
+	RateLimitState string
+	Body           string
+	Err            error
+}
+
+func newParseError(response *http.Response, body string, err error) *ParseError {
+	return &ParseError{
+		RequestId:      response.Header.Get("X-Request-Id"),
+		StatusCode:     response.StatusCode,
+		RateLimitState: response.Header.Get("X-RateLimit-State"),
+		Body:           body,
+		Err:            err,
+	}
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ParseError) Cause() error {
+	return e.Err
+}
+
+func (e *ParseError) GetRequestId() string {
+	return e.RequestId
+}
+
+func (e *ParseError) GetStatusCode() int {
+	return e.StatusCode
+}
+
+func (e *ParseError) GetRateLimitState() string {
+	return e.RateLimitState
+}
+
+func (e *ParseError) GetBody() string {
+	return e.Body
+}
+
+// bodySnippet keeps the first bytes written to it and discards the rest.
+type bodySnippet struct {
+	bytes.Buffer
+}
+
+func (s *bodySnippet) Write(p []byte) (int, error) {
+	if room := errorBodySnippetSize - s.Len(); room > 0 {
+		if len(p) > room {
+			s.Buffer.Write(p[:room])
+		} else {
+			s.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+type teeBody struct {
+	io.Reader
+	io.Closer
+}
+
+// captureBodySnippet makes reads of the response body also fill the returned snippet.
+func captureBodySnippet(response *http.Response) *bodySnippet {
+	snippet := &bodySnippet{}
+	response.Body = teeBody{io.TeeReader(response.Body, snippet), response.Body}
+	return snippet
+}
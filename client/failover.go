@@ -0,0 +1,63 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+)
+
+// keyFailover tracks which of Config.ApiKeys is in use. Once a key is rejected as unauthorized, or still throttled
+// after all retries, it is not used again. A forbidden request only fails over itself, as the key may lack the
+// rights for that request alone.
+type keyFailover struct {
+	mux   sync.Mutex
+	index int
+}
+
+func (cli *OpsGenieClient) currentApiKey() (string, int) {
+	cli.keys.mux.Lock()
+	defer cli.keys.mux.Unlock()
+	return cli.Config.ApiKeys[cli.keys.index], cli.keys.index
+}
+
+// shouldFailover reports whether the response means the key should be replaced: it was rejected, or requests are
+// still throttled after all retries.
+func shouldFailover(response *http.Response, exhausted bool) bool {
+	if response == nil {
+		return false
+	}
+	switch response.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	case http.StatusTooManyRequests:
+		return exhausted
+	}
+	return false
+}
+
+// failover switches the request, and unless it was forbidden the requests after it, to the next API key. It returns
+// false when the request doesn't use Config.ApiKeys or the last key is already in use. Concurrent requests that were
+// rejected with the same key switch to the same next key.
+func (cli *OpsGenieClient) failover(request *request, response *http.Response) bool {
+	if len(cli.Config.ApiKeys) == 0 || cli.Config.CredentialsProvider != nil {
+		return false
+	}
+	cli.keys.mux.Lock()
+	index := cli.keys.index
+	if index <= request.keyIndex {
+		index = request.keyIndex + 1
+		if index == len(cli.Config.ApiKeys) {
+			cli.keys.mux.Unlock()
+			return false
+		}
+		if response.StatusCode != http.StatusForbidden {
+			cli.keys.index = index
+		}
+		cli.Config.Logger.Warnf("API key %d was answered with status %d, failing over to API key %d",
+			request.keyIndex, response.StatusCode, index)
+	}
+	cli.keys.mux.Unlock()
+
+	request.keyIndex = index
+	request.Header.Set("Authorization", "GenieKey "+cli.Config.ApiKeys[index])
+	return true
+}
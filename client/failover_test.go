@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKeyFailover(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	var mux sync.Mutex
+	var keys []string
+	throttled := map[string]bool{"GenieKey key2": true}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Authorization")
+		mux.Lock()
+		keys = append(keys, key)
+		isThrottled := throttled[key]
+		mux.Unlock()
+		switch {
+		case key == "GenieKey key1":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "Key is disabled", "took": 0.1, "requestId": "rId"}`)
+		case isThrottled:
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"message": "Too many requests", "took": 0.1, "requestId": "rId"}`)
+		default:
+			fmt.Fprint(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+		}
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKeys:        []string{"key1", "key2", "key3"},
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		RetryCount:     1,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	var metrics []*HttpMetric
+	subscriber := MetricSubscriber{Process: func(metric Metric) interface{} {
+		metrics = append(metrics, metric.(*HttpMetric))
+		return nil
+	}}
+	subscriber.Register(HTTP)
+
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "processed", result.Data)
+	// key1 is rejected, key2 is throttled until retries run out, key3 succeeds.
+	assert.Equal(t, []string{"GenieKey key1", "GenieKey key2", "GenieKey key2", "GenieKey key3"}, keys)
+	assert.Equal(t, 1, len(metrics))
+	assert.Equal(t, 2, metrics[0].KeyIndex)
+
+	mux.Lock()
+	keys = nil
+	mux.Unlock()
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GenieKey key3"}, keys)
+
+	mux.Lock()
+	throttled["GenieKey key3"] = true
+	keys = nil
+	mux.Unlock()
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"GenieKey key3", "GenieKey key3"}, keys)
+}
+
+func TestApiKeyFailoverOnForbiddenRequest(t *testing.T) {
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Authorization")
+		keys = append(keys, key)
+		if key == "GenieKey key1" && r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message": "Not allowed", "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		fmt.Fprint(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKeys:        []string{"key1", "key2"},
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GenieKey key1", "GenieKey key2"}, keys)
+
+	// The forbidden request does not retire the key for other requests.
+	keys = nil
+	err = ogClient.Exec(nil, &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GenieKey key1"}, keys)
+}
+
+func TestApiKeysValidation(t *testing.T) {
+	_, err := NewOpsGenieClient(&Config{ApiKeys: []string{"key1", ""}})
+	assert.Equal(t, "API keys cannot contain a blank key.", err.Error())
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs API calls concurrently, like errgroup.Group with a limit: at most Concurrency calls run at once, each
+// call waits for the shared RateLimiter if one is set, and the first error returned by a call cancels the context
+// of the others. Calls that should not stop the group handle their errors themselves and return nil. Results are
+// collected by the calls, e.g. into a slice indexed by the position of the call.
+type Group struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	limiter *RateLimiter
+	slots   chan struct{}
+
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+}
+
+// NewGroup returns a group and the context its calls receive. A concurrency of zero or less defaults to 4.
+func NewGroup(ctx context.Context, concurrency int, limiter *RateLimiter) (*Group, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{
+		ctx:     ctx,
+		cancel:  cancel,
+		limiter: limiter,
+		slots:   make(chan struct{}, concurrency),
+	}, ctx
+}
+
+// Go starts the call as soon as fewer than Concurrency calls are running. It blocks until then. Calls are not
+// started once the context of the group is done; the context's error is then returned by Wait unless a call
+// failed first.
+func (g *Group) Go(call func(ctx context.Context) error) {
+	g.wg.Add(1)
+	select {
+	case g.slots <- struct{}{}:
+	case <-g.ctx.Done():
+		g.fail(g.ctx.Err())
+		g.wg.Done()
+		return
+	}
+	go func() {
+		defer func() {
+			<-g.slots
+			g.wg.Done()
+		}()
+		if g.limiter != nil {
+			if err := g.limiter.Wait(g.ctx); err != nil {
+				g.fail(err)
+				return
+			}
+		} else if err := g.ctx.Err(); err != nil {
+			g.fail(err)
+			return
+		}
+		if err := call(g.ctx); err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+func (g *Group) fail(err error) {
+	g.errOnce.Do(func() {
+		g.err = err
+		g.cancel()
+	})
+}
+
+// Wait waits for all started calls and returns the first error.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+	return g.err
+}
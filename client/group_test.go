@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	var running, maxRunning int32
+	group, _ := NewGroup(context.Background(), 2, nil)
+	results := make([]int, 6)
+	for i := range results {
+		i := i
+		group.Go(func(ctx context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			results[i] = i * i
+			return nil
+		})
+	}
+	assert.Nil(t, group.Wait())
+	assert.Equal(t, []int{0, 1, 4, 9, 16, 25}, results)
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= 2)
+}
+
+func TestGroupCancelsOnFirstError(t *testing.T) {
+	group, ctx := NewGroup(context.Background(), 1, nil)
+	var calls int32
+	group.Go(func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return errors.New("fatal")
+	})
+	group.Go(func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	assert.Equal(t, "fatal", group.Wait().Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, context.Canceled, ctx.Err())
+}
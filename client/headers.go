@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+type headersContextKey struct{}
+
+// WithHeaders returns a context that makes requests executed with it carry the given headers, in addition to the
+// ones set with WithHeaders on the parent context. Headers the client sets itself, such as Authorization,
+// Content-Type, Accept, User-Agent and the SDK version header, take precedence over them.
+func WithHeaders(ctx context.Context, headers http.Header) context.Context {
+	merged := http.Header{}
+	for name, values := range HeadersFromContext(ctx) {
+		merged[name] = append([]string(nil), values...)
+	}
+	for name, values := range headers {
+		merged[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+	}
+	return context.WithValue(ctx, headersContextKey{}, merged)
+}
+
+// HeadersFromContext returns the headers set with WithHeaders.
+func HeadersFromContext(ctx context.Context) http.Header {
+	headers, _ := ctx.Value(headersContextKey{}).(http.Header)
+	return headers
+}
+
+// setCustomHeaders adds the headers set with WithHeaders that the client did not set on the request already.
+func setCustomHeaders(ctx context.Context, req *request) {
+	for name, values := range HeadersFromContext(ctx) {
+		if _, ok := req.Header[name]; ok {
+			continue
+		}
+		req.Header[name] = append([]string(nil), values...)
+	}
+}
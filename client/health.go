@@ -0,0 +1,111 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultErrorBudgetWindow = 5 * time.Minute
+	errorBudgetBuckets       = 10
+)
+
+// ErrorBudget counts the requests sent within the window and how many of them failed. Transport errors, 5xx and
+// 429 responses count as failures; other 4xx responses are caused by the request and count as successes.
+type ErrorBudget struct {
+	Requests int
+	Failures int
+}
+
+// FailureRatio returns the ratio of failed requests, zero when nothing was sent.
+func (b ErrorBudget) FailureRatio() float64 {
+	if b.Requests == 0 {
+		return 0
+	}
+	return float64(b.Failures) / float64(b.Requests)
+}
+
+// HealthSnapshot describes the requests sent by a client within the last Window, overall and per rate limit domain.
+// The domain of a request is the first segment of its resource path after the API version, e.g. "alerts".
+type HealthSnapshot struct {
+	TakenAt time.Time
+	Window  time.Duration
+	Overall ErrorBudget
+	Domains map[string]ErrorBudget
+}
+
+type healthBucket struct {
+	start   time.Time
+	domains map[string]ErrorBudget
+}
+
+type healthTracker struct {
+	mux     sync.Mutex
+	buckets []healthBucket
+}
+
+// Health returns the error budgets of the requests sent within Config.ErrorBudgetWindow.
+func (cli *OpsGenieClient) Health() HealthSnapshot {
+	now := cli.Clock().Now()
+	window := cli.errorBudgetWindow()
+	snapshot := HealthSnapshot{TakenAt: now, Window: window, Domains: make(map[string]ErrorBudget)}
+
+	cli.health.mux.Lock()
+	defer cli.health.mux.Unlock()
+	for _, bucket := range cli.health.buckets {
+		if !bucket.start.After(now.Add(-window)) {
+			continue
+		}
+		for domain, budget := range bucket.domains {
+			total := snapshot.Domains[domain]
+			total.Requests += budget.Requests
+			total.Failures += budget.Failures
+			snapshot.Domains[domain] = total
+			snapshot.Overall.Requests += budget.Requests
+			snapshot.Overall.Failures += budget.Failures
+		}
+	}
+	return snapshot
+}
+
+func (cli *OpsGenieClient) errorBudgetWindow() time.Duration {
+	if cli.Config.ErrorBudgetWindow > 0 {
+		return cli.Config.ErrorBudgetWindow
+	}
+	return DefaultErrorBudgetWindow
+}
+
+func (cli *OpsGenieClient) recordHealth(resourcePath string, response *http.Response, err error) {
+	failed := err != nil || response == nil || response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+	domain := rateLimitDomain(resourcePath)
+	now := cli.Clock().Now()
+	bucketSize := cli.errorBudgetWindow() / errorBudgetBuckets
+	start := now.Truncate(bucketSize)
+
+	cli.health.mux.Lock()
+	defer cli.health.mux.Unlock()
+	buckets := cli.health.buckets
+	if len(buckets) == 0 || !buckets[len(buckets)-1].start.Equal(start) {
+		if len(buckets) > errorBudgetBuckets {
+			buckets = buckets[1:]
+		}
+		buckets = append(buckets, healthBucket{start: start, domains: make(map[string]ErrorBudget)})
+	}
+	budget := buckets[len(buckets)-1].domains[domain]
+	budget.Requests++
+	if failed {
+		budget.Failures++
+	}
+	buckets[len(buckets)-1].domains[domain] = budget
+	cli.health.buckets = buckets
+}
+
+func rateLimitDomain(resourcePath string) string {
+	segments := strings.Split(strings.Trim(resourcePath, "/"), "/")
+	if len(segments) > 1 && len(segments[0]) > 1 && segments[0][0] == 'v' {
+		return segments[1]
+	}
+	return segments[0]
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/alerts/failing":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "/v2/alerts/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			fmt.Fprint(w, `{"Data": "processed", "took": 0.1, "requestId": "rId"}`)
+		}
+	}))
+	defer ts.Close()
+
+	clock := NewManualClock(time.Date(2019, 12, 24, 0, 0, 0, 0, time.UTC))
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:            "apiKey",
+		OpsGenieAPIURL:    ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		RetryCount:        1,
+		Clock:             clock,
+		ErrorBudgetWindow: time.Minute,
+		Backoff: func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			return 0
+		},
+	})
+	assert.Nil(t, err)
+
+	execRaw := func(path string) {
+		response, _ := ogClient.ExecRaw(nil, http.MethodGet, path, nil, nil)
+		if response != nil {
+			response.Body.Close()
+		}
+	}
+	execRaw("/v2/alerts/failing")
+	execRaw("/v2/alerts/missing")
+	execRaw("/v2/alerts/ok")
+	execRaw("/v2/teams")
+
+	health := ogClient.Health()
+	assert.Equal(t, ErrorBudget{Requests: 4, Failures: 1}, health.Overall)
+	assert.Equal(t, ErrorBudget{Requests: 3, Failures: 1}, health.Domains["alerts"])
+	assert.InDelta(t, 1.0/3, health.Domains["alerts"].FailureRatio(), 0.001)
+	assert.Equal(t, ErrorBudget{Requests: 1}, health.Domains["teams"])
+
+	clock.Advance(40 * time.Second)
+	execRaw("/v2/alerts/failing")
+	assert.Equal(t, ErrorBudget{Requests: 5, Failures: 2}, ogClient.Health().Overall)
+
+	clock.Advance(30 * time.Second)
+	assert.Equal(t, ErrorBudget{Requests: 1, Failures: 1}, ogClient.Health().Overall)
+	clock.Advance(time.Minute)
+	assert.Equal(t, ErrorBudget{}, ogClient.Health().Overall)
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+type hedgeDelayContextKey struct{}
+
+// WithHedgeDelay returns a context that overrides Config.HedgeDelay for the requests executed with it. A zero delay
+// disables hedging.
+func WithHedgeDelay(ctx context.Context, delay time.Duration) context.Context {
+	return context.WithValue(ctx, hedgeDelayContextKey{}, delay)
+}
+
+func (cli *OpsGenieClient) hedgeDelay(ctx context.Context) time.Duration {
+	if delay, ok := ctx.Value(hedgeDelayContextKey{}).(time.Duration); ok {
+		return delay
+	}
+	return cli.Config.HedgeDelay
+}
+
+type hedgeOutcome struct {
+	index    int
+	response *http.Response
+	err      error
+}
+
+// cancelOnClose cancels the context of a hedged request once its body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// send sends a single attempt of a request. GET requests are hedged when a hedge delay is set: if no response
+// arrived within the delay a second request is sent, the first response wins and the other request is cancelled.
+func (cli *OpsGenieClient) send(req *http.Request) (*http.Response, error) {
+	httpClient := cli.httpClient(req.Context())
+	delay := cli.hedgeDelay(req.Context())
+	if delay <= 0 || req.Method != http.MethodGet {
+		return httpClient.Do(req)
+	}
+
+	outcomes := make(chan hedgeOutcome, 2)
+	var cancels []context.CancelFunc
+	launch := func() {
+		ctx, cancel := context.WithCancel(req.Context())
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			response, err := httpClient.Do(req.WithContext(ctx))
+			outcomes <- hedgeOutcome{index: index, response: response, err: err}
+		}()
+	}
+
+	launch()
+	pending := 1
+	hedge := make(chan struct{})
+	stop := cli.Clock().AfterFunc(delay, func() { close(hedge) })
+	defer stop()
+	var lastErr error
+	for pending > 0 {
+		select {
+		case outcome := <-outcomes:
+			pending--
+			if outcome.err == nil {
+				for i, cancel := range cancels {
+					if i != outcome.index {
+						cancel()
+					}
+				}
+				go discardOutcomes(outcomes, pending)
+				outcome.response.Body = &cancelOnClose{ReadCloser: outcome.response.Body, cancel: cancels[outcome.index]}
+				return outcome.response, nil
+			}
+			cancels[outcome.index]()
+			lastErr = outcome.err
+			if len(cancels) == 1 {
+				return nil, outcome.err
+			}
+		case <-hedge:
+			hedge = nil
+			cli.Config.Logger.Debugf("No response within %s, sending hedged request to %s", delay, req.URL.Path)
+			launch()
+			pending++
+		}
+	}
+	return nil, lastErr
+}
+
+func discardOutcomes(outcomes <-chan hedgeOutcome, pending int) {
+	for ; pending > 0; pending-- {
+		outcome := <-outcomes
+		if outcome.response != nil {
+			outcome.response.Body.Close()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHedgedRequests(t *testing.T) {
+	defer withoutMetricSubscribers()()
+
+	var requests int32
+	cancelled := make(chan struct{}, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+				cancelled <- struct{}{}
+				return
+			case <-time.After(10 * time.Second):
+			}
+		}
+		fmt.Fprint(w, `{"Data": "processed", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		HedgeDelay:     20 * time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	start := time.Now()
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testGetRequest{testRequest{MandatoryField: "afield"}}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "processed", result.Data)
+	assert.True(t, time.Since(start) < 5*time.Second)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow request was not cancelled")
+	}
+
+	// Hedging only applies to GET requests and can be disabled per request.
+	atomic.StoreInt32(&requests, 1)
+	err = ogClient.Exec(WithHedgeDelay(context.Background(), 0), &testGetRequest{testRequest{MandatoryField: "afield"}}, &testResult{})
+	assert.Nil(t, err)
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
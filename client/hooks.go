@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// Hooks are called synchronously while Exec and ExecRaw send requests, on the goroutine of the call. Unlike metrics
+// they receive the outgoing http request, so they can be used for audit trails or to throttle adaptively.
+type Hooks struct {
+	// OnRequestStart is called before every attempt to send a request.
+	OnRequestStart func(event AttemptEvent)
+	// OnRetry is called when an attempt failed and the request is about to be retried, before waiting for the
+	// backoff.
+	OnRetry func(event AttemptEvent, retry RetryEvent)
+	// OnResponse is called after every attempt with its response metadata, or the error when no response arrived.
+	OnResponse func(event ResponseEvent)
+}
+
+// AttemptEvent describes an attempt to send a request. Hooks must neither modify Request nor read its body.
+type AttemptEvent struct {
+	TransactionId string
+	ResourcePath  string
+	Request       *http.Request
+	Attempt       int
+}
+
+// ResponseEvent describes the outcome of an attempt. Metadata is nil when Err is set.
+type ResponseEvent struct {
+	AttemptEvent
+	StatusCode int
+	Metadata   *ResultMetadata
+	Duration   time.Duration
+	Err        error
+}
+
+func (cli *OpsGenieClient) hookRequestStart(event AttemptEvent) {
+	if cli.Config.Hooks.OnRequestStart != nil {
+		cli.Config.Hooks.OnRequestStart(event)
+	}
+}
+
+func (cli *OpsGenieClient) hookResponse(event AttemptEvent, response *http.Response, duration time.Duration, err error) {
+	if cli.Config.Hooks.OnResponse == nil {
+		return
+	}
+	responseEvent := ResponseEvent{AttemptEvent: event, Duration: duration, Err: err}
+	if response != nil {
+		responseEvent.StatusCode = response.StatusCode
+		responseEvent.Metadata = setResultMetadata(response, &ResultMetadata{})
+	}
+	cli.Config.Hooks.OnResponse(responseEvent)
+}
+
+func (cli *OpsGenieClient) hookRetry(event AttemptEvent, retry RetryEvent) {
+	if cli.Config.Hooks.OnRetry != nil {
+		cli.Config.Hooks.OnRetry(event, retry)
+	}
+}
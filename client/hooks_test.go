@@ -0,0 +1,67 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks(t *testing.T) {
+	attemptCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		w.Header().Set("X-Request-Id", fmt.Sprintf("rId-%d", attemptCount))
+		w.Header().Set("X-RateLimit-State", "THROTTLED")
+		if attemptCount == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintln(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	var calls []string
+	var responses []ResponseEvent
+	var retries []RetryEvent
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		RetryCount:     2,
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		Hooks: Hooks{
+			OnRequestStart: func(event AttemptEvent) {
+				assert.Equal(t, "/an-enpoint", event.Request.URL.Path)
+				calls = append(calls, fmt.Sprintf("start %d", event.Attempt))
+			},
+			OnRetry: func(event AttemptEvent, retry RetryEvent) {
+				calls = append(calls, fmt.Sprintf("retry %d", event.Attempt))
+				retries = append(retries, retry)
+			},
+			OnResponse: func(event ResponseEvent) {
+				calls = append(calls, fmt.Sprintf("response %d", event.Attempt))
+				responses = append(responses, event)
+			},
+		},
+	})
+	assert.Nil(t, err)
+	setZeroBackoff(ogClient)
+
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"start 1", "response 1", "retry 1", "start 2", "response 2"}, calls)
+	assert.Equal(t, 1, len(retries))
+	assert.Equal(t, http.StatusTooManyRequests, retries[0].StatusCode)
+	assert.Equal(t, 2, len(responses))
+	assert.Equal(t, http.StatusTooManyRequests, responses[0].StatusCode)
+	assert.Equal(t, "rId-1", responses[0].Metadata.RequestId)
+	assert.Equal(t, "THROTTLED", responses[0].Metadata.RateLimitState)
+	assert.Equal(t, http.StatusOK, responses[1].StatusCode)
+	assert.Equal(t, "rId-2", responses[1].Metadata.RequestId)
+	assert.Equal(t, responses[0].TransactionId, responses[1].TransactionId)
+	assert.Equal(t, "/an-enpoint", responses[1].ResourcePath)
+	assert.Nil(t, responses[1].Err)
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	cryptorand "crypto/rand"
+	"math/rand"
+	"time"
+)
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// IdGenerator returns a new unique identifier on every call.
+type IdGenerator func() string
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
+
+// NewULID returns a ULID, a 26 character identifier made of a millisecond timestamp followed by 80 random bits.
+// ULIDs sort lexicographically by creation time.
+func NewULID() string {
+	var id [16]byte
+	ms := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	for i := 0; i < 6; i++ {
+		id[i] = byte(ms >> uint(40-8*i))
+	}
+	if _, err := cryptorand.Read(id[6:]); err != nil {
+		rand.Read(id[6:])
+	}
+
+	// 26 characters of 5 bits cover 130 bits, so the 128 bit id is encoded as if prefixed with two zero bits.
+	encoded := make([]byte, 26)
+	for i := range encoded {
+		var value byte
+		for bit := i*5 - 2; bit < i*5+3; bit++ {
+			value <<= 1
+			if bit >= 0 {
+				value |= (id[bit/8] >> uint(7-bit%8)) & 1
+			}
+		}
+		encoded[i] = crockfordAlphabet[value]
+	}
+	return string(encoded)
+}
+
+func (cli *OpsGenieClient) newId() string {
+	if cli.Config.IdGenerator != nil {
+		return cli.Config.IdGenerator()
+	}
+	return NewULID()
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that makes write requests executed with it carry the given idempotency key.
+// The same key is sent on every retry of the request.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key set with WithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
+
+func (cli *OpsGenieClient) setIdempotencyKey(ctx context.Context, req *request) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return
+	}
+	key, ok := IdempotencyKeyFromContext(ctx)
+	if !ok {
+		if !cli.Config.IdempotencyKeys {
+			return
+		}
+		key = cli.newId()
+	}
+	req.Header.Set(IdempotencyKeyHeader, key)
+}
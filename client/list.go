@@ -0,0 +1,50 @@
+package client
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ListParams holds the paging, sorting and filtering parameters of list requests. Every list request keeps its own
+// named fields and builds its ListParams from the ones its endpoint supports, so the parameters are validated and
+// added the same way by all of them.
+type ListParams struct {
+	Limit  int
+	Offset int
+	Sort   string
+	Order  string
+	Query  string
+}
+
+func (p ListParams) Validate() error {
+	if p.Limit < 0 {
+		return errors.New("Limit cannot be negative.")
+	}
+	if p.Offset < 0 {
+		return errors.New("Offset cannot be negative.")
+	}
+	if p.Order != "" && p.Order != "asc" && p.Order != "desc" {
+		return errors.New("Order should be one of asc or desc.")
+	}
+	return nil
+}
+
+// AddTo adds the parameters that are set to the query parameters of a request.
+func (p ListParams) AddTo(params map[string]string) {
+	if p.Limit != 0 {
+		params["limit"] = strconv.Itoa(p.Limit)
+	}
+	if p.Offset != 0 {
+		params["offset"] = strconv.Itoa(p.Offset)
+	}
+	if p.Sort != "" {
+		params["sort"] = p.Sort
+	}
+	if p.Order != "" {
+		params["order"] = p.Order
+	}
+	if p.Query != "" {
+		params["query"] = p.Query
+	}
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListParams(t *testing.T) {
+	assert.Equal(t, "Limit cannot be negative.", ListParams{Limit: -1}.Validate().Error())
+	assert.Equal(t, "Offset cannot be negative.", ListParams{Offset: -1}.Validate().Error())
+	assert.Equal(t, "Order should be one of asc or desc.", ListParams{Order: "up"}.Validate().Error())
+
+	listParams := ListParams{Limit: 10, Offset: 20, Sort: "createdAt", Order: "desc", Query: "status:open"}
+	assert.Nil(t, listParams.Validate())
+	params := map[string]string{"identifierType": "id"}
+	listParams.AddTo(params)
+	assert.Equal(t, map[string]string{
+		"identifierType": "id",
+		"limit":          "10",
+		"offset":         "20",
+		"sort":           "createdAt",
+		"order":          "desc",
+		"query":          "status:open",
+	}, params)
+}
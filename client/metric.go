@@ -1,7 +1,7 @@
 package client
 
 import (
-	"math/rand"
+	"context"
 	"net/http"
 	"strconv"
 	"sync"
@@ -9,9 +9,16 @@ import (
 )
 
 const (
-	HTTP MetricType = "http"
-	SDK  MetricType = "sdk"
-	API  MetricType = "api"
+	HTTP      MetricType = "http"
+	SDK       MetricType = "sdk"
+	API       MetricType = "api"
+	SUBSYSTEM MetricType = "subsystem"
+)
+
+const (
+	TickEvent     = "tick"
+	FailureEvent  = "failure"
+	SuppressEvent = "suppress"
 )
 
 type Metric interface {
@@ -27,6 +34,8 @@ type HttpMetric struct {
 	Status        string  `json:"status,omitempty"`
 	StatusCode    int     `json:"statusCode,omitempty"`
 	HttpRequest   request `json:"request,omitempty"`
+	// KeyIndex is the index of the key of Config.ApiKeys the request was sent with.
+	KeyIndex int `json:"keyIndex"`
 }
 
 func (hm *HttpMetric) Type() string {
@@ -59,11 +68,39 @@ func (apiMetric *SdkMetric) Type() string {
 	return string(SDK)
 }
 
+// SubsystemMetric is published by background helpers, such as the heartbeat pinger, on every tick and failure.
+type SubsystemMetric struct {
+	Subsystem string        `json:"subsystem"`
+	Name      string        `json:"name"`
+	Event     string        `json:"event"`
+	Backoff   time.Duration `json:"backoff"`
+	Error     error         `json:"error,omitempty"`
+	Duration  int64         `json:"duration"`
+}
+
+func (subsystemMetric *SubsystemMetric) Type() string {
+	return string(SUBSYSTEM)
+}
+
+// PublishSubsystemMetric delivers the metric to the subscribers registered for the subsystem metric type.
+func PublishSubsystemMetric(ctx context.Context, metric *SubsystemMetric) {
+	metricPublisher.deliver(ctx, metric)
+}
+
+// PublishSubsystemMetric publishes the metric of a subsystem built on the client, queueing it like the metrics of
+// the client's requests when Config.MetricPublishing is set.
+func (cli *OpsGenieClient) PublishSubsystemMetric(ctx context.Context, metric *SubsystemMetric) {
+	cli.publishMetric(ctx, metric)
+}
+
 type Process func(metric Metric) interface{}
 
+// ContextProcess receives the context of the request the metric belongs to along with the metric.
+type ContextProcess func(ctx context.Context, metric Metric) interface{}
+
 type MetricType string
 
-var AvailableMetricTypes = []MetricType{HTTP, API, SDK}
+var AvailableMetricTypes = []MetricType{HTTP, API, SDK, SUBSYSTEM}
 
 type MetricPublisher struct {
 	SubscriberMap map[string][]MetricSubscriber
@@ -71,7 +108,8 @@ type MetricPublisher struct {
 }
 
 type MetricSubscriber struct {
-	Process Process
+	Process        Process
+	ContextProcess ContextProcess
 }
 
 func (s *MetricSubscriber) Register(metricType MetricType) {
@@ -85,12 +123,16 @@ func (s *MetricSubscriber) Register(metricType MetricType) {
 	metricPublisher.mux.Unlock()
 }
 
-func (mp *MetricPublisher) publish(metric Metric) {
+func (mp *MetricPublisher) deliver(ctx context.Context, metric Metric) {
 	for _, sub := range metricPublisher.SubscriberMap[metric.Type()] {
 		if sub.Process != nil {
 			m := metric //give copy of the object for all subs
 			sub.Process(m)
 		}
+		if sub.ContextProcess != nil {
+			m := metric
+			sub.ContextProcess(ctx, m)
+		}
 	}
 }
 
@@ -100,24 +142,6 @@ func duration(start, end int64) int64 {
 	return endMillisecond - startMillisecond
 }
 
-func generateTransactionId() string {
-	return randStringRunes(20)
-}
-
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
-var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890")
-
-func randStringRunes(n int) string {
-	b := make([]rune, n)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
-	}
-	return string(b)
-}
-
 func buildHttpMetric(transactionId string, resourcePath string, response *http.Response, err error, duration int64, httpRequest request) *HttpMetric {
 	retryCount, convErr := strconv.Atoi(response.Header.Get("retryCount"))
 	metric := &HttpMetric{
@@ -129,6 +153,7 @@ func buildHttpMetric(transactionId string, resourcePath string, response *http.R
 		HttpRequest:   httpRequest,
 		Status:        response.Status,
 		StatusCode:    response.StatusCode,
+		KeyIndex:      httpRequest.keyIndex,
 	}
 	if convErr == nil {
 		metric.RetryCount = retryCount
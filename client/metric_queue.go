@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type MetricOverflowPolicy int
+
+const (
+	// DeliverWhenFull delivers metrics that don't fit into the buffer in the request path, out of order, so none are
+	// lost. Requests are never blocked on the worker, which would deadlock subscribers that call the client.
+	DeliverWhenFull MetricOverflowPolicy = iota
+	// DropWhenFull drops metrics that don't fit into the buffer. See OpsGenieClient.DroppedMetrics.
+	DropWhenFull
+)
+
+// MetricPublishing configures how the metrics of a client are delivered to subscribers. Without it metrics are
+// delivered synchronously in the request path. Otherwise they are queued and delivered by a single worker of the
+// client, in order; ContextProcess subscribers may then receive contexts that are already done.
+type MetricPublishing struct {
+	BufferSize     int
+	OverflowPolicy MetricOverflowPolicy
+}
+
+type queuedMetric struct {
+	ctx    context.Context
+	metric Metric
+}
+
+type metricQueue struct {
+	queue  chan queuedMetric
+	policy MetricOverflowPolicy
+
+	mux    sync.Mutex
+	closed bool
+	// pending is the number of queued metrics not delivered yet, idle is closed whenever it is zero.
+	pending int
+	idle    chan struct{}
+
+	dropped uint64
+}
+
+func newMetricQueue(publishing MetricPublishing) *metricQueue {
+	bufferSize := publishing.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	idle := make(chan struct{})
+	close(idle)
+	q := &metricQueue{
+		queue:  make(chan queuedMetric, bufferSize),
+		policy: publishing.OverflowPolicy,
+		idle:   idle,
+	}
+	go func() {
+		for queued := range q.queue {
+			metricPublisher.deliver(queued.ctx, queued.metric)
+			q.delivered()
+		}
+	}()
+	return q
+}
+
+// publishMetric queues the metric if the client publishes metrics asynchronously and delivers it otherwise.
+func (cli *OpsGenieClient) publishMetric(ctx context.Context, metric Metric) {
+	if cli.metrics != nil && cli.metrics.enqueue(ctx, metric) {
+		return
+	}
+	metricPublisher.deliver(ctx, metric)
+}
+
+// DroppedMetrics returns the number of metrics dropped because the metric buffer of the client was full.
+func (cli *OpsGenieClient) DroppedMetrics() uint64 {
+	if cli.metrics == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&cli.metrics.dropped)
+}
+
+// FlushMetrics waits until all metrics queued by the client are delivered or the context is done.
+func (cli *OpsGenieClient) FlushMetrics(ctx context.Context) error {
+	if cli.metrics == nil {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cli.metrics.mux.Lock()
+	idle := cli.metrics.idle
+	cli.metrics.mux.Unlock()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-idle:
+		return nil
+	}
+}
+
+// enqueue queues the metric and reports whether it was handled. Metrics that don't fit into the buffer are dropped
+// or left to the caller to deliver, by the overflow policy, as are all metrics once the queue is closed.
+func (q *metricQueue) enqueue(ctx context.Context, metric Metric) bool {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	if q.closed {
+		return false
+	}
+	select {
+	case q.queue <- queuedMetric{ctx: ctx, metric: metric}:
+		if q.pending == 0 {
+			q.idle = make(chan struct{})
+		}
+		q.pending++
+		return true
+	default:
+	}
+	if q.policy == DropWhenFull {
+		atomic.AddUint64(&q.dropped, 1)
+		return true
+	}
+	return false
+}
+
+func (q *metricQueue) delivered() {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	q.pending--
+	if q.pending == 0 {
+		close(q.idle)
+	}
+}
+
+// close stops the worker once the queued metrics are delivered. Later metrics are delivered synchronously.
+func (q *metricQueue) close() {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	if !q.closed {
+		q.closed = true
+		close(q.queue)
+	}
+}
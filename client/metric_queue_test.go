@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsyncMetricPublishing(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	var mux sync.Mutex
+	var delivered []string
+	subscriber := MetricSubscriber{Process: func(metric Metric) interface{} {
+		if m, ok := metric.(*SubsystemMetric); ok && m.Subsystem == "queue-test" {
+			started <- struct{}{}
+			<-release
+			mux.Lock()
+			delivered = append(delivered, m.Name)
+			mux.Unlock()
+		}
+		return nil
+	}}
+	subscriber.Register(SUBSYSTEM)
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		MetricPublishing: &MetricPublishing{BufferSize: 1, OverflowPolicy: DropWhenFull},
+	})
+	assert.Nil(t, err)
+	otherClient, err := NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		MetricPublishing: &MetricPublishing{BufferSize: 1},
+	})
+	assert.Nil(t, err)
+
+	ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "first"})
+	<-started
+	ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "second"})
+	ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "third"})
+	assert.Equal(t, uint64(1), ogClient.DroppedMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, ogClient.FlushMetrics(ctx))
+	assert.Nil(t, otherClient.Shutdown(context.Background()))
+
+	close(release)
+	assert.Nil(t, ogClient.Shutdown(context.Background()))
+	assert.Equal(t, []string{"first", "second"}, delivered)
+
+	// Metrics published after shutdown are delivered synchronously.
+	ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "fourth"})
+	<-started
+	assert.Equal(t, []string{"first", "second", "fourth"}, delivered)
+}
+
+func TestAsyncMetricPublishingDeliversOverflowInRequestPath(t *testing.T) {
+	defer withoutMetricSubscribers()()
+	var ogClient *OpsGenieClient
+	var mux sync.Mutex
+	var delivered []string
+	subscriber := MetricSubscriber{Process: func(metric Metric) interface{} {
+		if m, ok := metric.(*SubsystemMetric); ok && m.Subsystem == "queue-test" {
+			if m.Name == "first" {
+				// A subscriber publishing from the worker while the buffer is full must not deadlock.
+				ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "nested-1"})
+				ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "nested-2"})
+			}
+			mux.Lock()
+			delivered = append(delivered, m.Name)
+			mux.Unlock()
+		}
+		return nil
+	}}
+	subscriber.Register(SUBSYSTEM)
+
+	var err error
+	ogClient, err = NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		MetricPublishing: &MetricPublishing{BufferSize: 1, OverflowPolicy: DeliverWhenFull},
+	})
+	assert.Nil(t, err)
+	ogClient.PublishSubsystemMetric(context.Background(), &SubsystemMetric{Subsystem: "queue-test", Name: "first"})
+	assert.Nil(t, ogClient.Shutdown(context.Background()))
+
+	mux.Lock()
+	defer mux.Unlock()
+	assert.ElementsMatch(t, []string{"first", "nested-1", "nested-2"}, delivered)
+	assert.Equal(t, uint64(0), ogClient.DroppedMetrics())
+}
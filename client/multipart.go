@@ -0,0 +1,118 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	fileFormField = "file"
+	sniffLength   = 512
+)
+
+// FileProvider is implemented by requests that upload a file. Exec sends such requests as multipart/form-data with
+// the file in the "file" field and FormFields as additional fields. The file is streamed, not buffered; File is
+// called again for every retry of the request.
+type FileProvider interface {
+	File() (name string, content io.ReadCloser, err error)
+	FormFields() map[string]string
+}
+
+// newMultipartBody opens the file of the provider and returns a function that streams the multipart body. The first
+// body uses the opened file, later ones open it again.
+func newMultipartBody(provider FileProvider) (func() (io.ReadCloser, error), string, error) {
+	name, content, err := provider.File()
+	if err != nil {
+		return nil, "", err
+	}
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+	opened := true
+
+	getBody := func() (io.ReadCloser, error) {
+		fileName, fileContent := name, content
+		if !opened {
+			fileName, fileContent, err = provider.File()
+			if err != nil {
+				return nil, err
+			}
+		}
+		opened = false
+
+		reader, writer := io.Pipe()
+		go func() {
+			defer fileContent.Close()
+			writer.CloseWithError(writeMultipart(writer, boundary, fileName, fileContent, provider.FormFields()))
+		}()
+		return reader, nil
+	}
+	return getBody, "multipart/form-data; boundary=" + boundary, nil
+}
+
+func writeMultipart(w io.Writer, boundary string, name string, content io.Reader, fields map[string]string) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return err
+	}
+
+	contentType, content := detectContentType(name, content)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, fileFormField, escapeQuotes(name)))
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(part, content); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err = writer.WriteField(key, fields[key]); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+// detectContentType uses the extension of the file name and falls back to sniffing the beginning of the content.
+func detectContentType(name string, content io.Reader) (string, io.Reader) {
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		return contentType, content
+	}
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	head = head[:n]
+	content = io.MultiReader(bytes.NewReader(head), content)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		content = io.MultiReader(bytes.NewReader(head), errReader{err})
+	}
+	return http.DetectContentType(head), content
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
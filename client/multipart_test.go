@@ -0,0 +1,115 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFileRequest struct {
+	BaseRequest
+	name    string
+	content string
+	err     error
+	opened  int
+}
+
+func (r *testFileRequest) Validate() error {
+	return nil
+}
+
+func (r *testFileRequest) ResourcePath() string {
+	return "/an-upload"
+}
+
+func (r *testFileRequest) Method() string {
+	return http.MethodPost
+}
+
+func (r *testFileRequest) File() (string, io.ReadCloser, error) {
+	if r.err != nil {
+		return "", nil, r.err
+	}
+	r.opened++
+	name := r.name
+	if name == "" {
+		name = "notes.txt"
+	}
+	return name, ioutil.NopCloser(strings.NewReader(r.content)), nil
+}
+
+func (r *testFileRequest) FormFields() map[string]string {
+	return map[string]string{"user": "john@example.com", "indexFile": "index.html"}
+}
+
+func TestExecMultipart(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data; boundary="))
+		file, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "notes.txt", header.Filename)
+		assert.Equal(t, "text/plain; charset=utf-8", header.Header.Get("Content-Type"))
+		assert.Equal(t, "some notes", string(content))
+		assert.Equal(t, "john@example.com", r.FormValue("user"))
+		assert.Equal(t, "index.html", r.FormValue("indexFile"))
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"Data": "uploaded", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	result := &testResult{}
+	err = ogClient.Exec(nil, &testFileRequest{content: "some notes"}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "uploaded", result.Data)
+
+	err = ogClient.Exec(nil, &testFileRequest{err: errors.New("file not found")}, result)
+	assert.EqualError(t, err, "file not found")
+}
+
+func TestExecMultipartRetriesAndSniffing(t *testing.T) {
+	attempts := 0
+	var contentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		file, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		content, _ := ioutil.ReadAll(file)
+		assert.Equal(t, "\x89PNG\r\n\x1a\nimage", string(content))
+		contentType = header.Header.Get("Content-Type")
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"Data": "uploaded", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+	setZeroBackoff(ogClient)
+
+	request := &testFileRequest{name: "screenshot", content: "\x89PNG\r\n\x1a\nimage"}
+	err = ogClient.Exec(nil, request, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 2, request.opened)
+	assert.Equal(t, "image/png", contentType)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PathSegment escapes an identifier, such as an alert alias or a heartbeat name, so it is sent as a single segment
+// of a resource path even when it contains characters like "/", "?" or spaces.
+func PathSegment(identifier string) string {
+	return url.PathEscape(identifier)
+}
+
+// validateResourcePath rejects resource paths with empty or relative segments, which identifiers that are empty or
+// consist of dots produce and which would address another resource.
+func validateResourcePath(path string) error {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "" && i < len(segments)-1:
+			return errors.Errorf("Resource path %s cannot have empty segments.", path)
+		case segment == "." || segment == "..":
+			return errors.Errorf("Resource path %s cannot have relative segments.", path)
+		case strings.IndexFunc(segment, isControl) >= 0:
+			return errors.Errorf("Resource path %s cannot have control characters.", path)
+		}
+	}
+	return nil
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testIdentifierRequest struct {
+	testGetRequest
+}
+
+func (r testIdentifierRequest) ResourcePath() string {
+	return "/v2/heartbeats/" + PathSegment(r.MandatoryField) + "/ping"
+}
+
+func TestPathSegment(t *testing.T) {
+	assert.Equal(t, "db%2Fprimary%3F%20eu", PathSegment("db/primary? eu"))
+	assert.Equal(t, "plain-name_1", PathSegment("plain-name_1"))
+}
+
+func TestValidateResourcePath(t *testing.T) {
+	assert.Nil(t, validateResourcePath("/v2/alerts/"))
+	assert.Nil(t, validateResourcePath("/v2/heartbeats/db%2Fprimary/ping"))
+	assert.EqualError(t, validateResourcePath("/v2/heartbeats//ping"), "Resource path /v2/heartbeats//ping cannot have empty segments.")
+	assert.EqualError(t, validateResourcePath("/v2/heartbeats/../ping"), "Resource path /v2/heartbeats/../ping cannot have relative segments.")
+	assert.EqualError(t, validateResourcePath("/v2/heartbeats/a\nb"), "Resource path /v2/heartbeats/a\nb cannot have control characters.")
+}
+
+func TestExecEscapesIdentifierSegments(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		fmt.Fprintln(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	err = ogClient.Exec(nil, &testIdentifierRequest{testGetRequest{testRequest{MandatoryField: "db/primary? 100%"}}}, &testResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/v2/heartbeats/db%2Fprimary%3F%20100%25/ping"}, paths)
+
+	err = ogClient.Exec(nil, &testIdentifierRequest{testGetRequest{testRequest{MandatoryField: ".."}}}, &testResult{})
+	assert.EqualError(t, err, "Resource path /v2/heartbeats/../ping cannot have relative segments.")
+	assert.Equal(t, 1, len(paths))
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EndpointProfile overrides the timeouts and retries of the requests whose resource path matches Pattern, so
+// latency critical and bulk endpoints can be served by the same client. Zero values keep the client settings.
+type EndpointProfile struct {
+	// Pattern matches resource paths like path.Match does, a trailing * matches any suffix, e.g. /v2/alerts*.
+	Pattern string
+	// RequestTimeout bounds each attempt, reading the response body included.
+	RequestTimeout time.Duration
+	// RetryCount replaces Config.RetryCount. DisableRetries sends a single attempt.
+	RetryCount     int
+	DisableRetries bool
+	// MaxElapsedTime replaces Config.MaxElapsedTime.
+	MaxElapsedTime time.Duration
+}
+
+func (p EndpointProfile) Validate() error {
+	if p.Pattern == "" {
+		return errors.New("Endpoint profile pattern cannot be empty.")
+	}
+	if _, err := path.Match(p.Pattern, ""); err != nil {
+		return errors.Wrapf(err, "Endpoint profile pattern %s is not valid", p.Pattern)
+	}
+	if p.RequestTimeout < 0 || p.RetryCount < 0 || p.MaxElapsedTime < 0 {
+		return errors.Errorf("Endpoint profile %s cannot have negative timeouts or retry count.", p.Pattern)
+	}
+	return nil
+}
+
+func (p EndpointProfile) matches(resourcePath string) bool {
+	if strings.HasSuffix(p.Pattern, "*") && strings.HasPrefix(resourcePath, strings.TrimSuffix(p.Pattern, "*")) {
+		return true
+	}
+	matched, _ := path.Match(p.Pattern, resourcePath)
+	return matched
+}
+
+// endpointProfile returns the first profile matching the resource path, the zero profile when none matches.
+func (cli *OpsGenieClient) endpointProfile(resourcePath string) EndpointProfile {
+	for _, profile := range cli.Config.EndpointProfiles {
+		if profile.matches(resourcePath) {
+			return profile
+		}
+	}
+	return EndpointProfile{}
+}
+
+func (p EndpointProfile) retryMax(defaultRetryMax int) int {
+	if p.DisableRetries {
+		return 0
+	}
+	if p.RetryCount > 0 {
+		return p.RetryCount
+	}
+	return defaultRetryMax
+}
+
+// withAttemptTimeout bounds a single attempt to the request timeout of the call's profile. The returned cancel
+// func is nil when the profile has no timeout.
+func withAttemptTimeout(req *http.Request, budget *RetryBudget) (*http.Request, context.CancelFunc) {
+	if budget == nil || budget.requestTimeout <= 0 {
+		return req, nil
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), budget.requestTimeout)
+	return req.WithContext(ctx), cancel
+}
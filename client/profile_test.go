@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointProfileMatches(t *testing.T) {
+	assert.True(t, EndpointProfile{Pattern: "/v2/alerts*"}.matches("/v2/alerts"))
+	assert.True(t, EndpointProfile{Pattern: "/v2/alerts*"}.matches("/v2/alerts/123/notes"))
+	assert.False(t, EndpointProfile{Pattern: "/v2/alerts*"}.matches("/v2/logs/list"))
+	assert.True(t, EndpointProfile{Pattern: "/v2/schedules/*/overrides"}.matches("/v2/schedules/ops/overrides"))
+	assert.False(t, EndpointProfile{Pattern: "/v2/schedules/*/overrides"}.matches("/v2/schedules/ops/overrides/alias"))
+}
+
+func TestEndpointProfileValidate(t *testing.T) {
+	err := Config{ApiKey: "apiKey", EndpointProfiles: []EndpointProfile{{}}}.Validate()
+	assert.Equal(t, "Endpoint profile pattern cannot be empty.", err.Error())
+
+	err = Config{ApiKey: "apiKey", EndpointProfiles: []EndpointProfile{{Pattern: "/v2/logs*", RetryCount: -1}}}.Validate()
+	assert.Equal(t, "Endpoint profile /v2/logs* cannot have negative timeouts or retry count.", err.Error())
+
+	err = Config{ApiKey: "apiKey", EndpointProfiles: []EndpointProfile{{Pattern: "/v2/[alerts"}}}.Validate()
+	assert.Contains(t, err.Error(), "Endpoint profile pattern /v2/[alerts is not valid")
+}
+
+func TestEndpointProfiles(t *testing.T) {
+	var mux sync.Mutex
+	attempts := make(map[string]int)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		attempts[r.URL.Path]++
+		mux.Unlock()
+		if r.URL.Path == "/v2/logs/list" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		RetryCount:     2,
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		EndpointProfiles: []EndpointProfile{
+			{Pattern: "/an-enpoint*", DisableRetries: true},
+			{Pattern: "/v2/logs*", RequestTimeout: 10 * time.Millisecond, RetryCount: 1},
+		},
+	})
+	assert.Nil(t, err)
+	setZeroBackoff(ogClient)
+
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts["/an-enpoint"])
+
+	_, err = ogClient.ExecRaw(context.Background(), http.MethodGet, "/v2/logs/list", nil, nil)
+	assert.NotNil(t, err)
+	exhausted, ok := err.(*RetriesExhaustedError)
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(exhausted.Attempts))
+	assert.Contains(t, exhausted.Attempts[0].Error, "context deadline exceeded")
+
+	response, err := ogClient.ExecRaw(context.Background(), http.MethodGet, "/v2/alerts", nil, nil)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	mux.Lock()
+	defer mux.Unlock()
+	assert.Equal(t, 3, attempts["/v2/alerts"])
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const defaultMaxRedirects = 10
+
+// RedirectPolicy controls how redirect responses are followed, e.g. download links of attachments and logs that
+// redirect to storage hosts.
+type RedirectPolicy struct {
+	// MaxRedirects is the number of redirects followed. Defaults to 10.
+	MaxRedirects int
+	// DisableRedirects returns redirect responses instead of following them.
+	DisableRedirects bool
+	// ForwardAuthorization keeps the Authorization header on redirects to other hosts. It is only kept on redirects
+	// to the same host otherwise.
+	ForwardAuthorization bool
+}
+
+// RedirectLimitError is returned when a request was redirected more often than MaxRedirects allows. It is not
+// retried.
+type RedirectLimitError struct {
+	MaxRedirects int
+}
+
+func (e *RedirectLimitError) Error() string {
+	return "Stopped after " + strconv.Itoa(e.MaxRedirects) + " redirects."
+}
+
+func isRedirectLimitError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	_, ok := err.(*RedirectLimitError)
+	return ok
+}
+
+type redirectPolicyContextKey struct{}
+
+// WithRedirectPolicy returns a context that overrides Config.RedirectPolicy for the requests executed with it.
+func WithRedirectPolicy(ctx context.Context, policy RedirectPolicy) context.Context {
+	return context.WithValue(ctx, redirectPolicyContextKey{}, policy)
+}
+
+func (cli *OpsGenieClient) redirectPolicy(ctx context.Context) (RedirectPolicy, bool) {
+	if policy, ok := ctx.Value(redirectPolicyContextKey{}).(RedirectPolicy); ok {
+		return policy, true
+	}
+	if cli.Config.RedirectPolicy != nil {
+		return *cli.Config.RedirectPolicy, true
+	}
+	return RedirectPolicy{}, false
+}
+
+// setRedirectPolicy makes the http client follow redirects by the redirect policy of each request when
+// Config.RedirectPolicy is set.
+func setRedirectPolicy(cli *OpsGenieClient) {
+	if cli.Config.RedirectPolicy == nil {
+		return
+	}
+	httpClient := cli.RetryableClient.HTTPClient
+	httpClient.CheckRedirect = cli.checkRedirect(httpClient.CheckRedirect)
+}
+
+// httpClient returns the http client to send the request with. Without Config.RedirectPolicy, requests whose context
+// has a redirect policy are sent with a copy of the http client that follows it.
+func (cli *OpsGenieClient) httpClient(ctx context.Context) *http.Client {
+	httpClient := cli.RetryableClient.HTTPClient
+	if cli.Config.RedirectPolicy != nil {
+		return httpClient
+	}
+	if _, ok := ctx.Value(redirectPolicyContextKey{}).(RedirectPolicy); !ok {
+		return httpClient
+	}
+	withPolicy := *httpClient
+	withPolicy.CheckRedirect = cli.checkRedirect(httpClient.CheckRedirect)
+	return &withPolicy
+}
+
+// checkRedirect checks redirects by the redirect policy of each request. Requests without a policy are checked by
+// previous, if any.
+func (cli *OpsGenieClient) checkRedirect(previous func(req *http.Request, via []*http.Request) error) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		policy, ok := cli.redirectPolicy(req.Context())
+		if !ok && previous != nil {
+			return previous(req, via)
+		}
+		if !ok {
+			// The default policy of the http client.
+			if len(via) >= defaultMaxRedirects {
+				return errors.Errorf("stopped after %d redirects", defaultMaxRedirects)
+			}
+			return nil
+		}
+		if policy.DisableRedirects {
+			return http.ErrUseLastResponse
+		}
+		maxRedirects := policy.MaxRedirects
+		if maxRedirects <= 0 {
+			maxRedirects = defaultMaxRedirects
+		}
+		if len(via) > maxRedirects {
+			return &RedirectLimitError{MaxRedirects: maxRedirects}
+		}
+		original := via[0]
+		if req.URL.Host != original.URL.Host && !policy.ForwardAuthorization {
+			req.Header.Del("Authorization")
+		} else if authorization := original.Header.Get("Authorization"); authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedirectPolicy(t *testing.T) {
+	var storageAuthorization []string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuthorization = append(storageAuthorization, r.Header.Get("Authorization"))
+		w.Write([]byte("attachment"))
+	}))
+	defer storage.Close()
+	// Redirect to another host, 127.0.0.1 and localhost are different hosts for the http client.
+	storageUrl := strings.Replace(storage.URL, "127.0.0.1", "localhost", 1)
+
+	redirects := 0
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/alerts/1/attachments/2":
+			http.Redirect(w, r, storageUrl+"/file", http.StatusFound)
+		case "/v2/loop":
+			redirects++
+			http.Redirect(w, r, "/v2/loop", http.StatusFound)
+		}
+	}))
+	defer api.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(api.URL, "http://")),
+		RedirectPolicy: &RedirectPolicy{MaxRedirects: 3},
+	})
+	assert.Nil(t, err)
+
+	response, err := ogClient.ExecRaw(nil, http.MethodGet, "/v2/alerts/1/attachments/2", nil, nil)
+	assert.Nil(t, err)
+	body, _ := ioutil.ReadAll(response.Body)
+	response.Body.Close()
+	assert.Equal(t, "attachment", string(body))
+	assert.Equal(t, []string{""}, storageAuthorization)
+
+	ctx := WithRedirectPolicy(context.Background(), RedirectPolicy{ForwardAuthorization: true})
+	response, err = ogClient.ExecRaw(ctx, http.MethodGet, "/v2/alerts/1/attachments/2", nil, nil)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, "GenieKey apiKey", storageAuthorization[1])
+
+	ctx = WithRedirectPolicy(context.Background(), RedirectPolicy{DisableRedirects: true})
+	response, err = ogClient.ExecRaw(ctx, http.MethodGet, "/v2/alerts/1/attachments/2", nil, nil)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, http.StatusFound, response.StatusCode)
+	assert.Equal(t, 2, len(storageAuthorization))
+
+	_, err = ogClient.ExecRaw(nil, http.MethodGet, "/v2/loop", nil, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Stopped after 3 redirects.")
+	_, ok := err.(*url.Error).Err.(*RedirectLimitError)
+	assert.True(t, ok)
+	assert.Equal(t, 4, redirects)
+}
+
+func TestRedirectPolicyDoesNotChangeHttpClient(t *testing.T) {
+	var storageAuthorization []string
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		storageAuthorization = append(storageAuthorization, r.Header.Get("Authorization"))
+	}))
+	defer storage.Close()
+	storageUrl := strings.Replace(storage.URL, "127.0.0.1", "localhost", 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storageUrl+"/file", http.StatusFound)
+	}))
+	defer api.Close()
+
+	httpClient := &http.Client{}
+	for _, policy := range []*RedirectPolicy{nil, {MaxRedirects: 3}} {
+		_, err := NewOpsGenieClient(&Config{
+			ApiKey:         "apiKey",
+			OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(api.URL, "http://")),
+			HttpClient:     httpClient,
+			RedirectPolicy: policy,
+		})
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, httpClient.CheckRedirect)
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(api.URL, "http://")),
+		HttpClient:     httpClient,
+	})
+	assert.Nil(t, err)
+	ctx := WithRedirectPolicy(context.Background(), RedirectPolicy{ForwardAuthorization: true})
+	response, err := ogClient.ExecRaw(ctx, http.MethodGet, "/v2/alerts/1/attachments/2", nil, nil)
+	assert.Nil(t, err)
+	response.Body.Close()
+	assert.Equal(t, []string{"GenieKey apiKey"}, storageAuthorization)
+}
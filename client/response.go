@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ResponseTooLargeError is returned when a response body exceeds Config.MaxResponseSize.
+type ResponseTooLargeError struct {
+	ResourcePath string
+	Limit        int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return "Response of " + e.ResourcePath + " exceeds the maximum response size of " + strconv.FormatInt(e.Limit, 10) + " bytes"
+}
+
+// internalHeaders are added to responses by the client to pass details of a single execution, such as its retries,
+// to the result metadata. They are not cached.
+var internalHeaders = []string{retryCountHeader, throttledCountHeader}
+
+// cloneHeader copies the header, like http.Header.Clone, which is only available since Go 1.13.
+func cloneHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+// cacheableHeader copies the header without the internal headers of the client.
+func cacheableHeader(header http.Header) http.Header {
+	clone := cloneHeader(header)
+	for _, key := range internalHeaders {
+		clone.Del(key)
+	}
+	return clone
+}
+
+// cancelBody releases the context of an ExecRaw call once the caller closes the response body.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+	err       *ResponseTooLargeError
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, b.err
+	}
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), b.err
+	}
+	return n, err
+}
+
+func limitResponseSize(response *http.Response, resourcePath string, limit int64) error {
+	tooLarge := &ResponseTooLargeError{ResourcePath: resourcePath, Limit: limit}
+	if response.ContentLength > limit {
+		return tooLarge
+	}
+	response.Body = &limitedBody{ReadCloser: response.Body, remaining: limit, err: tooLarge}
+	return nil
+}
+
+// StreamResult can be embedded by results of endpoints that return binary content, such as file downloads.
+// Parse copies the response body to Writer instead of decoding it as JSON.
+type StreamResult struct {
+	ResultMetadata
+	Writer  io.Writer `json:"-"`
+	Written int64     `json:"-"`
+}
+
+func (sr *StreamResult) Parse(response *http.Response, result ApiResult) error {
+	if response == nil {
+		return errors.New("No response received")
+	}
+	if sr.Writer == nil {
+		return errors.New("Writer cannot be empty.")
+	}
+	written, err := io.Copy(sr.Writer, response.Body)
+	sr.Written = written
+	return err
+}
+
+func (sr *StreamResult) acceptsAnyContent() {}
+
+type streamingResult interface {
+	acceptsAnyContent()
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryEvent is emitted each time a request is about to be retried.
+type RetryEvent struct {
+	TransactionId string
+	ResourcePath  string
+	Method        string
+	Attempt       int
+	Wait          time.Duration
+	StatusCode    int
+	Cause         error
+}
+
+type RetryEventHandler func(event RetryEvent)
+
+// RetryEventChannel returns a handler that forwards events to the given channel.
+// Events are dropped when the channel is not ready to receive, so a slow consumer never blocks a request.
+func RetryEventChannel(events chan<- RetryEvent) RetryEventHandler {
+	return func(event RetryEvent) {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+func buildRetryEvent(transactionId string, resourcePath string, method string, attempt int, wait time.Duration, response *http.Response, err error) RetryEvent {
+	event := RetryEvent{
+		TransactionId: transactionId,
+		ResourcePath:  resourcePath,
+		Method:        method,
+		Attempt:       attempt,
+		Wait:          wait,
+		Cause:         err,
+	}
+	if response != nil {
+		event.StatusCode = response.StatusCode
+		if err == nil {
+			event.Cause = errors.New("Received status code " + strconv.Itoa(response.StatusCode))
+		}
+	}
+	return event
+}
+
+func (cli *OpsGenieClient) publishRetryEvent(event RetryEvent) {
+	if cli.Config.RetryEventHandler != nil {
+		cli.Config.RetryEventHandler(event)
+	}
+}
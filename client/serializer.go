@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Serializer encodes request bodies and decodes response bodies. JsonSerializer, which wraps encoding/json, is used
+// when Config.Serializer is not set.
+type Serializer interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+type JsonSerializer struct{}
+
+func (JsonSerializer) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JsonSerializer) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+var defaultSerializer Serializer = JsonSerializer{}
+
+type serializerContextKey struct{}
+
+func (cli *OpsGenieClient) serializer() Serializer {
+	if cli.Config.Serializer != nil {
+		return cli.Config.Serializer
+	}
+	return defaultSerializer
+}
+
+func withSerializer(ctx context.Context, serializer Serializer) context.Context {
+	return context.WithValue(ctx, serializerContextKey{}, serializer)
+}
+
+func serializerOf(response *http.Response) Serializer {
+	if response != nil && response.Request != nil {
+		if serializer, ok := response.Request.Context().Value(serializerContextKey{}).(Serializer); ok {
+			return serializer
+		}
+	}
+	return defaultSerializer
+}
+
+// CheckSerializerConformance verifies that the given serializer produces the same bytes as encoding/json for each
+// sample and that decoding them back yields values equal to the ones decoded by encoding/json.
+func CheckSerializerConformance(serializer Serializer, samples ...interface{}) error {
+	for _, sample := range samples {
+		expected, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		actual, err := serializer.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expected, actual) {
+			return errors.Errorf("Serialized output differs for %T, expected %s but got %s", sample, expected, actual)
+		}
+
+		expectedValue := reflect.New(reflect.TypeOf(sample))
+		actualValue := reflect.New(reflect.TypeOf(sample))
+		if err := json.Unmarshal(expected, expectedValue.Interface()); err != nil {
+			return err
+		}
+		if err := serializer.Unmarshal(expected, actualValue.Interface()); err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(expectedValue.Interface(), actualValue.Interface()) {
+			return errors.Errorf("Deserialized value differs for %T", sample)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrClientClosed is returned for requests executed after Shutdown or Close was called.
+var ErrClientClosed = errors.New("Client is closed.")
+
+type lifecycle struct {
+	mux      sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// begin registers an in-flight request. The returned function must be called when the request is done.
+func (cli *OpsGenieClient) begin() (func(), error) {
+	cli.lifecycle.mux.Lock()
+	defer cli.lifecycle.mux.Unlock()
+	if cli.lifecycle.closed {
+		return nil, ErrClientClosed
+	}
+	cli.lifecycle.inFlight.Add(1)
+	return cli.lifecycle.inFlight.Done, nil
+}
+
+// Shutdown stops the client from accepting new requests, waits for in-flight requests to finish, flushes queued
+// metrics and closes the idle connections of its transport. Response bodies returned by ExecRaw are not waited for.
+// If the context is done first, its error is returned and idle connections are left open.
+func (cli *OpsGenieClient) Shutdown(ctx context.Context) error {
+	cli.lifecycle.mux.Lock()
+	cli.lifecycle.closed = true
+	cli.lifecycle.mux.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		cli.lifecycle.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+	}
+	if err := cli.FlushMetrics(ctx); err != nil {
+		return err
+	}
+	if cli.metrics != nil {
+		cli.metrics.close()
+	}
+
+	cli.RetryableClient.HTTPClient.CloseIdleConnections()
+	return nil
+}
+
+// Close is Shutdown without a deadline.
+func (cli *OpsGenieClient) Close() error {
+	return cli.Shutdown(context.Background())
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	placeholderStart = "{{"
+	placeholderEnd   = "}}"
+	// escapedPlaceholderStart and escapedPlaceholderEnd are the delimiters after PathSegment escaped them.
+	escapedPlaceholderStart = "%7B%7B"
+	escapedPlaceholderEnd   = "%7D%7D"
+)
+
+// Placeholder returns the placeholder to put into the string fields and identifiers of a request compiled with
+// CompileTemplate, e.g. Message: client.Placeholder("message").
+func Placeholder(name string) string {
+	return placeholderStart + name + placeholderEnd
+}
+
+// RequestTemplate is a request validated and marshaled once, for requests sent at high frequency that only differ
+// in a few string values, such as heartbeat pings and deduplicated alerts. Placeholders in the resource path and
+// body are replaced with the values given to Request, which are escaped but not validated. Query parameters are
+// sent as compiled. A template can be used concurrently.
+type RequestTemplate struct {
+	method       string
+	path         []templatePart
+	body         []templatePart
+	params       map[string]string
+	metadata     map[string]interface{}
+	placeholders []string
+}
+
+// templatePart is either a literal or a placeholder.
+type templatePart struct {
+	literal     []byte
+	placeholder string
+}
+
+// templateRequest is a request built from a template. Exec sends its body as is.
+type templateRequest struct {
+	template *RequestTemplate
+	path     string
+	body     []byte
+}
+
+// CompileTemplate validates the request and marshals it with the serializer of the client. Requests uploading
+// files or form data cannot be compiled.
+func (cli *OpsGenieClient) CompileTemplate(apiRequest ApiRequest) (*RequestTemplate, error) {
+	if err := validateRequest(apiRequest); err != nil {
+		return nil, err
+	}
+	metadata := apiRequest.Metadata(apiRequest)
+	if _, ok := apiRequest.(FileProvider); ok {
+		return nil, errors.New("Requests uploading files cannot be compiled.")
+	}
+	if _, ok := metadata["form-data-values"]; ok {
+		return nil, errors.New("Requests with form data cannot be compiled.")
+	}
+
+	template := &RequestTemplate{
+		method:   apiRequest.Method(),
+		params:   apiRequest.RequestParams(),
+		metadata: metadata,
+	}
+	seen := make(map[string]bool)
+	template.path = template.compile([]byte(apiRequest.ResourcePath()), escapedPlaceholderStart, escapedPlaceholderEnd, seen)
+	if apiRequest.Method() != http.MethodGet && apiRequest.Method() != http.MethodDelete {
+		body, err := cli.serializer().Marshal(apiRequest)
+		if err != nil {
+			return nil, err
+		}
+		template.body = template.compile(body, placeholderStart, placeholderEnd, seen)
+	}
+	return template, nil
+}
+
+// compile splits the content into literals and the placeholders between start and end.
+func (t *RequestTemplate) compile(content []byte, start string, end string, seen map[string]bool) []templatePart {
+	var parts []templatePart
+	for {
+		i := bytes.Index(content, []byte(start))
+		if i < 0 {
+			break
+		}
+		j := bytes.Index(content[i+len(start):], []byte(end))
+		if j < 0 {
+			break
+		}
+		name := string(content[i+len(start) : i+len(start)+j])
+		if !isPlaceholderName(name) {
+			parts = append(parts, templatePart{literal: content[:i+len(start)]})
+			content = content[i+len(start):]
+			continue
+		}
+		parts = append(parts, templatePart{literal: content[:i]}, templatePart{placeholder: name})
+		if !seen[name] {
+			seen[name] = true
+			t.placeholders = append(t.placeholders, name)
+		}
+		content = content[i+len(start)+j+len(end):]
+	}
+	return append(parts, templatePart{literal: content})
+}
+
+func isPlaceholderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// Placeholders returns the names of the placeholders of the template in the order they first appear.
+func (t *RequestTemplate) Placeholders() []string {
+	return append([]string(nil), t.placeholders...)
+}
+
+// Request returns a request with the placeholders replaced with the values, to be executed with Exec. Every
+// placeholder must have a value; values of unknown placeholders are ignored.
+func (t *RequestTemplate) Request(values map[string]string) (ApiRequest, error) {
+	for _, name := range t.placeholders {
+		if _, ok := values[name]; !ok {
+			return nil, errors.Errorf("Placeholder %s has no value.", name)
+		}
+	}
+	var path strings.Builder
+	for _, part := range t.path {
+		if part.placeholder == "" {
+			path.Write(part.literal)
+		} else {
+			path.WriteString(PathSegment(values[part.placeholder]))
+		}
+	}
+	request := &templateRequest{template: t, path: path.String()}
+	if t.body != nil {
+		var body []byte
+		for _, part := range t.body {
+			if part.placeholder == "" {
+				body = append(body, part.literal...)
+			} else {
+				body = appendJsonEscaped(body, values[part.placeholder])
+			}
+		}
+		request.body = body
+	}
+	return request, nil
+}
+
+// appendJsonEscaped appends the value escaped to be placed inside a JSON string.
+func appendJsonEscaped(dst []byte, value string) []byte {
+	const hex = "0123456789abcdef"
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\\':
+			dst = append(dst, '\\', c)
+		case c == '\n':
+			dst = append(dst, '\\', 'n')
+		case c == '\r':
+			dst = append(dst, '\\', 'r')
+		case c == '\t':
+			dst = append(dst, '\\', 't')
+		case c < 0x20:
+			dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return dst
+}
+
+func (r *templateRequest) Validate() error {
+	return nil
+}
+
+func (r *templateRequest) ResourcePath() string {
+	return r.path
+}
+
+func (r *templateRequest) Method() string {
+	return r.template.method
+}
+
+func (r *templateRequest) Metadata(apiRequest ApiRequest) map[string]interface{} {
+	return r.template.metadata
+}
+
+func (r *templateRequest) RequestParams() map[string]string {
+	return r.template.params
+}
+
+// ExecTemplate executes the request of the template with the values.
+func (cli *OpsGenieClient) ExecTemplate(ctx context.Context, template *RequestTemplate, values map[string]string, result ApiResult) error {
+	request, err := template.Request(values)
+	if err != nil {
+		return err
+	}
+	return cli.Exec(ctx, request, result)
+}
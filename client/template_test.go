@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTemplateRequest struct {
+	testRequest
+	Name string `json:"-"`
+}
+
+func (tr testTemplateRequest) ResourcePath() string {
+	return "/v2/things/" + PathSegment(tr.Name) + "/notes"
+}
+
+func TestRequestTemplate(t *testing.T) {
+	var path, body, contentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		contentType = r.Header.Get("Content-Type")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = ogClient.CompileTemplate(&testTemplateRequest{})
+	assert.Equal(t, "mandatory field cannot be empty", err.Error())
+
+	template, err := ogClient.CompileTemplate(&testTemplateRequest{
+		testRequest: testRequest{MandatoryField: Placeholder("message"), ExtraField: "{{not a placeholder}} " + Placeholder("message")},
+		Name:        Placeholder("name"),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"name", "message"}, template.Placeholders())
+
+	err = ogClient.ExecTemplate(context.Background(), template, map[string]string{"name": "thing"}, &testResult{})
+	assert.Equal(t, "Placeholder message has no value.", err.Error())
+
+	result := &testResult{}
+	err = ogClient.ExecTemplate(context.Background(), template, map[string]string{
+		"name":    "a/b",
+		"message": "disk \"full\"\n\x01",
+		"unused":  "ignored",
+	}, result)
+	assert.Nil(t, err)
+	assert.Equal(t, "processed", result.Data)
+	assert.Equal(t, "/v2/things/a%2Fb/notes", path)
+	assert.Equal(t, "application/json; charset=utf-8", contentType)
+	assert.Equal(t, `{"MandatoryField":"disk \"full\"\n\u0001","ExtraField":"{{not a placeholder}} disk \"full\"\n\u0001"}`, body)
+}
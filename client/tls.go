@@ -0,0 +1,120 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TLSConfiguration customizes how the client verifies the server and authenticates itself. Certificates can be
+// given as PEM encoded bytes or as file paths. Config, when set, is used as the base configuration.
+type TLSConfiguration struct {
+	CACertPEM      []byte
+	CACertFile     string
+	ClientCertPEM  []byte
+	ClientKeyPEM   []byte
+	ClientCertFile string
+	ClientKeyFile  string
+	MinVersion     uint16
+	Config         *tls.Config
+}
+
+func (tc *TLSConfiguration) build() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if tc.Config != nil {
+		tlsConfig = tc.Config.Clone()
+	}
+	if tc.MinVersion != 0 {
+		tlsConfig.MinVersion = tc.MinVersion
+	}
+
+	caCert := tc.CACertPEM
+	if tc.CACertFile != "" {
+		var err error
+		caCert, err = ioutil.ReadFile(tc.CACertFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not read CA certificate")
+		}
+	}
+	if caCert != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("CA certificate does not contain a valid PEM encoded certificate.")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	var certificate tls.Certificate
+	var err error
+	if tc.ClientCertFile != "" || tc.ClientKeyFile != "" {
+		certificate, err = tls.LoadX509KeyPair(tc.ClientCertFile, tc.ClientKeyFile)
+	} else if tc.ClientCertPEM != nil || tc.ClientKeyPEM != nil {
+		certificate, err = tls.X509KeyPair(tc.ClientCertPEM, tc.ClientKeyPEM)
+	} else {
+		return tlsConfig, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not load client certificate")
+	}
+	tlsConfig.Certificates = append(tlsConfig.Certificates, certificate)
+	return tlsConfig, nil
+}
+
+func setTLSSettings(cli *OpsGenieClient) error {
+	tlsConfig, err := cli.Config.TLSConfiguration.build()
+	if err != nil {
+		return err
+	}
+	t, err := transport(cli)
+	if err != nil {
+		return err
+	}
+	t.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// transport returns the *http.Transport of the underlying http client that transport settings are applied to. The
+// configured transport, or http.DefaultTransport if there is none, is copied on the first call, so transports
+// shared with the caller or other clients are never changed. Custom round trippers are never replaced.
+func transport(cli *OpsGenieClient) (*http.Transport, error) {
+	if cli.ownTransport != nil {
+		return cli.ownTransport, nil
+	}
+	var base *http.Transport
+	switch t := cli.RetryableClient.HTTPClient.Transport.(type) {
+	case *http.Transport:
+		base = t
+	case nil:
+		if httpTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+			base = httpTransport
+		} else {
+			base = defaultTransport()
+		}
+	default:
+		return nil, errors.Errorf("Transport settings cannot be applied to a custom RoundTripper of type %T.", t)
+	}
+	cli.ownTransport = cloneTransport(base)
+	cli.RetryableClient.HTTPClient.Transport = cli.ownTransport
+	return cli.ownTransport, nil
+}
+
+// defaultTransport has the settings of http.DefaultTransport, for programs that replaced it with another round
+// tripper.
+func defaultTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSConfigurationWithCustomCA(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+
+	ogClient, err := NewOpsGenieClient(&Config{ApiKey: "apiKey"})
+	assert.Nil(t, err)
+	_, err = ogClient.RetryableClient.HTTPClient.Get(ts.URL)
+	assert.NotNil(t, err)
+
+	ogClient, err = NewOpsGenieClient(&Config{
+		ApiKey: "apiKey",
+		TLSConfiguration: &TLSConfiguration{
+			CACertPEM:  caCert,
+			MinVersion: tls.VersionTLS12,
+		},
+	})
+	assert.Nil(t, err)
+	response, err := ogClient.RetryableClient.HTTPClient.Get(ts.URL)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	httpTransport, err := transport(ogClient)
+	assert.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), httpTransport.TLSClientConfig.MinVersion)
+}
+
+func TestTLSConfigurationValidation(t *testing.T) {
+	_, err := NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		TLSConfiguration: &TLSConfiguration{CACertPEM: []byte("not a certificate")},
+	})
+	assert.EqualError(t, err, "CA certificate does not contain a valid PEM encoded certificate.")
+
+	_, err = NewOpsGenieClient(&Config{
+		ApiKey:           "apiKey",
+		TLSConfiguration: &TLSConfiguration{ClientCertFile: "missing.crt", ClientKeyFile: "missing.key"},
+	})
+	assert.Contains(t, err.Error(), "Could not load client certificate")
+}
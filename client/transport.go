@@ -0,0 +1,25 @@
+package client
+
+func (conf Config) connectionPoolConfigured() bool {
+	return conf.MaxIdleConns != 0 || conf.MaxIdleConnsPerHost != 0 || conf.IdleConnTimeout != 0 || conf.ForceHTTP2
+}
+
+func setConnectionPoolSettings(cli *OpsGenieClient) error {
+	t, err := transport(cli)
+	if err != nil {
+		return err
+	}
+	if cli.Config.MaxIdleConns > 0 {
+		t.MaxIdleConns = cli.Config.MaxIdleConns
+	}
+	if cli.Config.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = cli.Config.MaxIdleConnsPerHost
+	}
+	if cli.Config.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = cli.Config.IdleConnTimeout
+	}
+	if cli.Config.ForceHTTP2 {
+		return forceHTTP2(t)
+	}
+	return nil
+}
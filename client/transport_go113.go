@@ -0,0 +1,15 @@
+//go:build go1.13
+// +build go1.13
+
+package client
+
+import "net/http"
+
+func cloneTransport(t *http.Transport) *http.Transport {
+	return t.Clone()
+}
+
+func forceHTTP2(t *http.Transport) error {
+	t.ForceAttemptHTTP2 = true
+	return nil
+}
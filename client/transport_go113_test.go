@@ -0,0 +1,18 @@
+//go:build go1.13
+// +build go1.13
+
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForceHTTP2(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{ApiKey: "apiKey", ForceHTTP2: true})
+	assert.Nil(t, err)
+	httpTransport, err := transport(ogClient)
+	assert.Nil(t, err)
+	assert.True(t, httpTransport.ForceAttemptHTTP2)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectionPoolSettings(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:              "apiKey",
+		MaxIdleConns:        200,
+		MaxIdleConnsPerHost: 50,
+		IdleConnTimeout:     time.Minute,
+	})
+	assert.Nil(t, err)
+	httpTransport, err := transport(ogClient)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, httpTransport.MaxIdleConns)
+	assert.Equal(t, 50, httpTransport.MaxIdleConnsPerHost)
+	assert.Equal(t, time.Minute, httpTransport.IdleConnTimeout)
+
+	_, err = NewOpsGenieClient(&Config{ApiKey: "apiKey", MaxIdleConns: -1})
+	assert.Equal(t, "Connection pool settings cannot be negative.", err.Error())
+
+	_, err = NewOpsGenieClient(&Config{
+		ApiKey:       "apiKey",
+		Transport:    roundTripperFunc(func(r *http.Request) (*http.Response, error) { return nil, nil }),
+		MaxIdleConns: 10,
+	})
+	assert.Contains(t, err.Error(), "Transport settings cannot be applied to a custom RoundTripper")
+}
+
+func TestTransportSettingsDoNotChangeSharedTransports(t *testing.T) {
+	httpClient := &http.Client{}
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		HttpClient:     httpClient,
+		RequestTimeout: time.Second,
+		MaxIdleConns:   200,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, httpClient.Transport)
+	assert.Equal(t, time.Duration(0), httpClient.Timeout)
+	httpTransport, err := transport(ogClient)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, httpTransport.MaxIdleConns)
+	assert.NotNil(t, httpTransport.Proxy)
+	assert.NotEqual(t, 200, http.DefaultTransport.(*http.Transport).MaxIdleConns)
+
+	sharedTransport := &http.Transport{MaxIdleConnsPerHost: 5}
+	ogClient, err = NewOpsGenieClient(&Config{
+		ApiKey:       "apiKey",
+		Transport:    sharedTransport,
+		MaxIdleConns: 200,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sharedTransport.MaxIdleConns)
+	httpTransport, err = transport(ogClient)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, httpTransport.MaxIdleConnsPerHost)
+	assert.Equal(t, 200, httpTransport.MaxIdleConns)
+}
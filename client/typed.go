@@ -0,0 +1,24 @@
+//go:build go1.21
+// +build go1.21
+
+package client
+
+import "context"
+
+// ExecTyped executes the request and returns a newly allocated result of type R, so the result type is checked at
+// compile time and does not need to be allocated by the caller:
+//
+//	result, err := client.ExecTyped[alert.GetAlertResult](ctx, opsGenieClient, request)
+//
+// It is only available when building with Go 1.21 or later, the first version that enables generics for single
+// files of modules declaring an older Go version.
+func ExecTyped[R any, PR interface {
+	*R
+	ApiResult
+}](ctx context.Context, cli *OpsGenieClient, request ApiRequest) (*R, error) {
+	result := PR(new(R))
+	if err := cli.Exec(ctx, request, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,36 @@
+//go:build go1.21
+// +build go1.21
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecTyped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Data": "processed", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	result, err := ExecTyped[testResult](nil, ogClient, &testRequest{MandatoryField: "afield"})
+	assert.Nil(t, err)
+	assert.Equal(t, "processed", result.Data)
+	assert.Equal(t, "rId", result.RequestId)
+
+	result, err = ExecTyped[testResult](nil, ogClient, &testRequest{})
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "mandatory field cannot be empty")
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Usage counts the activity of a client. Calls are Exec and ExecRaw calls, Retries the attempts after their first
+// one and Throttled the attempts that got a 429 response. Failed calls returned an error or an error response.
+type Usage struct {
+	Calls         int64
+	Failures      int64
+	Retries       int64
+	Throttled     int64
+	BytesSent     int64
+	BytesReceived int64
+}
+
+// UsageSummary describes the activity of a client since Since, overall and per rate limit domain.
+type UsageSummary struct {
+	Since   time.Time
+	Total   Usage
+	Domains map[string]Usage
+}
+
+type usageTracker struct {
+	since   time.Time
+	mux     sync.Mutex
+	domains map[string]*Usage
+}
+
+// Usage returns the activity of the client since it was created.
+func (cli *OpsGenieClient) Usage() UsageSummary {
+	tracker := &cli.usage
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	summary := UsageSummary{Since: tracker.since, Domains: make(map[string]Usage, len(tracker.domains))}
+	for domain, usage := range tracker.domains {
+		current := Usage{
+			Calls:         atomic.LoadInt64(&usage.Calls),
+			Failures:      atomic.LoadInt64(&usage.Failures),
+			Retries:       atomic.LoadInt64(&usage.Retries),
+			Throttled:     atomic.LoadInt64(&usage.Throttled),
+			BytesSent:     atomic.LoadInt64(&usage.BytesSent),
+			BytesReceived: atomic.LoadInt64(&usage.BytesReceived),
+		}
+		summary.Domains[domain] = current
+		summary.Total.Calls += current.Calls
+		summary.Total.Failures += current.Failures
+		summary.Total.Retries += current.Retries
+		summary.Total.Throttled += current.Throttled
+		summary.Total.BytesSent += current.BytesSent
+		summary.Total.BytesReceived += current.BytesReceived
+	}
+	return summary
+}
+
+func (cli *OpsGenieClient) domainUsage(resourcePath string) *Usage {
+	tracker := &cli.usage
+	domain := rateLimitDomain(resourcePath)
+	tracker.mux.Lock()
+	defer tracker.mux.Unlock()
+	if tracker.domains == nil {
+		tracker.domains = make(map[string]*Usage)
+	}
+	usage, ok := tracker.domains[domain]
+	if !ok {
+		usage = &Usage{}
+		tracker.domains[domain] = usage
+	}
+	return usage
+}
+
+func (cli *OpsGenieClient) recordCall(resourcePath string, response *http.Response, err error) {
+	usage := cli.domainUsage(resourcePath)
+	atomic.AddInt64(&usage.Calls, 1)
+	if err != nil || response == nil || response.StatusCode >= 400 {
+		atomic.AddInt64(&usage.Failures, 1)
+	}
+}
+
+// recordAttempt counts a single attempt and the bytes of its response body as they are read.
+func (cli *OpsGenieClient) recordAttempt(resourcePath string, attempt int, request *http.Request, response *http.Response) {
+	usage := cli.domainUsage(resourcePath)
+	if attempt > 1 {
+		atomic.AddInt64(&usage.Retries, 1)
+	}
+	if request.ContentLength > 0 {
+		atomic.AddInt64(&usage.BytesSent, request.ContentLength)
+	}
+	if response == nil {
+		return
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&usage.Throttled, 1)
+	}
+	if response.Body != nil {
+		response.Body = &countingBody{ReadCloser: response.Body, count: &usage.BytesReceived}
+	}
+}
+
+type countingBody struct {
+	io.ReadCloser
+	count *int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(b.count, int64(n))
+	return n, err
+}
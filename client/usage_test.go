@@ -0,0 +1,51 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsage(t *testing.T) {
+	attemptCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		if attemptCount == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"Data": "processed", "took": 1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		RetryCount:     3,
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+	setZeroBackoff(ogClient)
+
+	assert.Equal(t, 0, len(ogClient.Usage().Domains))
+
+	err = ogClient.Exec(nil, &testRequest{MandatoryField: "afield"}, &testResult{})
+	assert.Nil(t, err)
+
+	usage := ogClient.Usage()
+	assert.False(t, usage.Since.IsZero())
+	assert.Equal(t, int64(1), usage.Total.Calls)
+	assert.Equal(t, int64(0), usage.Total.Failures)
+	assert.Equal(t, int64(1), usage.Total.Retries)
+	assert.Equal(t, int64(1), usage.Total.Throttled)
+	assert.True(t, usage.Total.BytesSent > 0)
+	assert.Equal(t, int64(len(`{"Data": "processed", "took": 1, "requestId": "rId"}`)), usage.Total.BytesReceived)
+	assert.Equal(t, 1, len(usage.Domains))
+	for _, domainUsage := range usage.Domains {
+		assert.Equal(t, usage.Total, domainUsage)
+	}
+}
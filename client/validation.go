@@ -0,0 +1,199 @@
+package client
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidationError is a request field that broke a rule of its validate tag. Field is the path of the field named
+// after its json names, e.g. responders[0].type.
+type ValidationError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors is returned by ValidateStruct with every field that broke a rule.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ValidateStruct validates the fields of a struct against the rules of their validate tags, separated by commas:
+//
+//	required   the field cannot be empty
+//	min=N      strings, slices and maps cannot be shorter than N, numbers cannot be less than N
+//	max=N      strings, slices and maps cannot be longer than N, numbers cannot be greater than N
+//	oneof=a b  the field, when set, must be one of the space separated values
+//	nested     structs, pointers to structs and slices of them are validated as well
+//
+// Embedded structs are always validated. Exec validates every request with it before calling its Validate method.
+func ValidateStruct(v interface{}) error {
+	var errs ValidationErrors
+	validateValue(reflect.ValueOf(v), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateRequest(request ApiRequest) error {
+	if _, ok := request.(*templateRequest); ok {
+		// Validated when the template was compiled.
+		return validateResourcePath(request.ResourcePath())
+	}
+	if err := ValidateStruct(request); err != nil {
+		return err
+	}
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	return validateResourcePath(request.ResourcePath())
+}
+
+func validateValue(value reflect.Value, path string, errs *ValidationErrors) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		validateFields(value, path, errs)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			validateValue(value.Index(i), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	}
+}
+
+func validateFields(value reflect.Value, path string, errs *ValidationErrors) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		if field.Anonymous {
+			validateValue(value.Field(i), path, errs)
+			continue
+		}
+		tag, ok := field.Tag.Lookup("validate")
+		if !ok || field.PkgPath != "" {
+			continue
+		}
+		fieldPath := fieldName(field)
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+		for _, rule := range strings.Split(tag, ",") {
+			if rule == "nested" {
+				validateValue(value.Field(i), fieldPath, errs)
+				continue
+			}
+			if message, ok := checkRule(value.Field(i), rule); !ok {
+				*errs = append(*errs, ValidationError{Field: fieldPath, Rule: rule, Message: fieldPath + " " + message})
+			}
+		}
+	}
+}
+
+func fieldName(field reflect.StructField) string {
+	name := strings.TrimSpace(strings.Split(field.Tag.Get("json"), ",")[0])
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// checkRule returns the reason the value breaks the rule, completing "<field> ...".
+func checkRule(value reflect.Value, rule string) (string, bool) {
+	name, arg := rule, ""
+	if i := strings.Index(rule, "="); i >= 0 {
+		name, arg = rule[:i], rule[i+1:]
+	}
+	switch name {
+	case "required":
+		if isEmpty(value) {
+			return "cannot be empty.", false
+		}
+	case "min", "max":
+		limit, err := strconv.Atoi(arg)
+		if err != nil {
+			return "has an invalid " + name + " rule.", false
+		}
+		size, unit, ok := measure(value)
+		if !ok || isEmpty(value) {
+			break
+		}
+		if name == "min" && size < int64(limit) {
+			if unit == "" {
+				return "cannot be less than " + arg + ".", false
+			}
+			return "cannot be shorter than " + arg + unit + ".", false
+		}
+		if name == "max" && size > int64(limit) {
+			if unit == "" {
+				return "cannot be greater than " + arg + ".", false
+			}
+			return "cannot be longer than " + arg + unit + ".", false
+		}
+	case "oneof":
+		if value.Kind() != reflect.String || value.Len() == 0 {
+			break
+		}
+		options := strings.Fields(arg)
+		for _, option := range options {
+			if value.String() == option {
+				return "", true
+			}
+		}
+		return "should be one of " + strings.Join(options, ", ") + ".", false
+	}
+	return "", true
+}
+
+// measure returns the length of strings, slices and maps or the value of numbers.
+func measure(value reflect.Value) (int64, string, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return int64(utf8.RuneCountInString(value.String())), " characters", true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return int64(value.Len()), " items", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), "", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint()), "", true
+	}
+	return 0, "", false
+}
+
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	}
+	if !value.CanInterface() {
+		return false
+	}
+	return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatedRecipient struct {
+	Type string `json:"type,omitempty" validate:"required,oneof=user team"`
+	Name string `json:"name,omitempty" validate:"max=5"`
+}
+
+type validatedRequest struct {
+	testRequest
+	Message    string               `json:"message" validate:"required,max=10"`
+	Recipients []validatedRecipient `json:"recipients" validate:"max=2,nested"`
+	Owner      *validatedRecipient  `validate:"nested"`
+	Count      int                  `json:"count" validate:"min=1,max=3"`
+	Untagged   string
+}
+
+func TestValidateStruct(t *testing.T) {
+	request := &validatedRequest{
+		Message:    "message",
+		Recipients: []validatedRecipient{{Type: "user", Name: "john"}},
+		Count:      2,
+	}
+	assert.Nil(t, ValidateStruct(request))
+
+	request = &validatedRequest{
+		Message:    strings.Repeat("m", 11),
+		Recipients: []validatedRecipient{{Type: "user"}, {Type: "group", Name: "toolong"}, {Type: "team"}},
+		Owner:      &validatedRecipient{},
+		Count:      5,
+	}
+	err := ValidateStruct(request)
+	validationErrors, ok := err.(ValidationErrors)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"message", "recipients", "recipients[1].type", "recipients[1].name", "Owner.type", "count"}, fieldsOf(validationErrors))
+	assert.Equal(t, "message cannot be longer than 10 characters.", validationErrors[0].Message)
+	assert.Equal(t, "recipients[1].type should be one of user, team.", validationErrors[2].Message)
+	assert.Equal(t, "Owner.type cannot be empty.", validationErrors[4].Message)
+	assert.Equal(t, "count cannot be greater than 3.", validationErrors[5].Message)
+
+	// Zero numbers are treated as unset, empty fields are only rejected by required.
+	err = ValidateStruct(&validatedRequest{})
+	assert.Equal(t, "message cannot be empty.", err.Error())
+}
+
+func TestExecValidatesTags(t *testing.T) {
+	ogClient, err := NewOpsGenieClient(&Config{ApiKey: "apiKey"})
+	assert.Nil(t, err)
+
+	err = ogClient.Exec(nil, &validatedRequest{testRequest: testRequest{MandatoryField: "afield"}}, &testResult{})
+	assert.Equal(t, "message cannot be empty.", err.Error())
+}
+
+func fieldsOf(errs ValidationErrors) []string {
+	fields := make([]string, len(errs))
+	for i, err := range errs {
+		fields[i] = err.Field
+	}
+	return fields
+}
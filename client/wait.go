@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMaxWait         = time.Minute
+	defaultWaitIntervalMin = 500 * time.Millisecond
+	defaultWaitIntervalMax = 5 * time.Second
+)
+
+// RequestStatus is a request status result that can tell whether an async request has been processed successfully.
+type RequestStatus interface {
+	ApiResult
+	Succeeded() bool
+	EntityId() string
+	FailureReason() string
+}
+
+// WaitOptions controls how WaitForCompletion polls. Zero values fall back to defaults.
+type WaitOptions struct {
+	MaxWait     time.Duration
+	IntervalMin time.Duration
+	IntervalMax time.Duration
+}
+
+// AsyncStatusRequest is implemented by request status requests that know the id of the async request they check.
+type AsyncStatusRequest interface {
+	ApiRequest
+	AsyncRequestId() string
+}
+
+// AsyncProcessingError is returned by WaitForCompletion when OpsGenie processed the async request but could not
+// apply it, as opposed to errors of the requests polling its status. RequestId is the id of the async request.
+type AsyncProcessingError struct {
+	RequestId string
+	Reason    string
+}
+
+func (e *AsyncProcessingError) Error() string {
+	if e.RequestId == "" {
+		return "Request could not be processed: " + e.Reason
+	}
+	return "Request " + e.RequestId + " could not be processed: " + e.Reason
+}
+
+// AsAsyncProcessingError returns the AsyncProcessingError err is or wraps.
+func AsAsyncProcessingError(err error) (*AsyncProcessingError, bool) {
+	processingErr, ok := errors.Cause(err).(*AsyncProcessingError)
+	return processingErr, ok
+}
+
+// WaitForCompletion polls the request status until the async request is processed and returns the id of the
+// affected entity. Polling stops when the context is done or MaxWait elapses.
+func (ar *AsyncBaseResult) WaitForCompletion(ctx context.Context, request ApiRequest, result RequestStatus, options WaitOptions) (string, error) {
+	ctx, err := ar.Client.defaultContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if options.MaxWait <= 0 {
+		options.MaxWait = DefaultMaxWait
+	}
+	if options.IntervalMin <= 0 {
+		options.IntervalMin = defaultWaitIntervalMin
+	}
+	if options.IntervalMax < options.IntervalMin {
+		options.IntervalMax = defaultWaitIntervalMax
+		if options.IntervalMax < options.IntervalMin {
+			options.IntervalMax = options.IntervalMin
+		}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	expired := make(chan struct{})
+	stop := ar.Client.Clock().AfterFunc(options.MaxWait, func() {
+		close(expired)
+		cancel()
+	})
+	defer stop()
+
+	for i := 0; ; i++ {
+		err := ar.Client.Exec(ctx, request, result)
+		if err == nil {
+			if !result.Succeeded() {
+				processingErr := &AsyncProcessingError{Reason: result.FailureReason()}
+				if statusRequest, ok := request.(AsyncStatusRequest); ok {
+					processingErr.RequestId = statusRequest.AsyncRequestId()
+				}
+				return "", processingErr
+			}
+			return result.EntityId(), nil
+		}
+		apiErr, ok := err.(*ApiError)
+		if !ok || apiErr.StatusCode != 404 || apiErr.ErrorHeader != "RequestNotProcessed" {
+			return "", err
+		}
+
+		wait := retryablehttp.DefaultBackoff(options.IntervalMin, options.IntervalMax, i, nil)
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			select {
+			case <-expired:
+				err = context.DeadlineExceeded
+			default:
+			}
+			return "", errors.Wrap(err, "Request was not processed in time")
+		case <-ar.Client.Clock().After(wait):
+		}
+	}
+}
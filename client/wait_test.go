@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatusResult struct {
+	ResultMetadata
+	IsSuccess bool   `json:"isSuccess"`
+	Status    string `json:"status"`
+	AlertId   string `json:"alertId"`
+}
+
+func (r *testStatusResult) Succeeded() bool {
+	return r.IsSuccess
+}
+
+func (r *testStatusResult) EntityId() string {
+	return r.AlertId
+}
+
+func (r *testStatusResult) FailureReason() string {
+	return r.Status
+}
+
+func waitTestServer(pendingAttempts int, body string, attemptCount *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attemptCount++
+		if *attemptCount <= pendingAttempts {
+			w.Header().Add("X-Opsgenie-Errortype", "RequestNotProcessed")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "request not processed", "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		fmt.Fprint(w, body)
+	}))
+}
+
+func TestWaitForCompletion(t *testing.T) {
+	attemptCount := 0
+	ts := waitTestServer(5, `{"data": {"isSuccess": true, "status": "Created alert", "alertId": "a1"}, "took": 0.1, "requestId": "rId"}`, &attemptCount)
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		RetryCount:     1,
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	asyncBaseResult := AsyncBaseResult{Client: ogClient}
+	entityId, err := asyncBaseResult.WaitForCompletion(nil, &testRequest{MandatoryField: "afield"}, &testStatusResult{}, WaitOptions{
+		IntervalMin: time.Millisecond,
+		IntervalMax: time.Millisecond,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "a1", entityId)
+	assert.Equal(t, 6, attemptCount)
+}
+
+func TestWaitForCompletionFailedRequest(t *testing.T) {
+	attemptCount := 0
+	ts := waitTestServer(0, `{"data": {"isSuccess": false, "status": "Alert does not exist"}, "took": 0.1, "requestId": "rId"}`, &attemptCount)
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	asyncBaseResult := AsyncBaseResult{Client: ogClient}
+	_, err = asyncBaseResult.WaitForCompletion(context.Background(), &testRequest{MandatoryField: "afield"}, &testStatusResult{}, WaitOptions{})
+	assert.EqualError(t, err, "Request could not be processed: Alert does not exist")
+	_, ok := err.(*AsyncProcessingError)
+	assert.True(t, ok)
+}
+
+type testStatusRequest struct {
+	testGetRequest
+}
+
+func (r *testStatusRequest) AsyncRequestId() string {
+	return "asyncRequestId"
+}
+
+func TestWaitForCompletionAsyncProcessingError(t *testing.T) {
+	attemptCount := 0
+	ts := waitTestServer(1, `{"data": {"isSuccess": false, "status": "Alert does not exist"}, "took": 0.1, "requestId": "rId"}`, &attemptCount)
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	asyncBaseResult := AsyncBaseResult{Client: ogClient}
+	_, err = asyncBaseResult.WaitForCompletion(context.Background(), &testStatusRequest{testGetRequest{testRequest{MandatoryField: "afield"}}}, &testStatusResult{}, WaitOptions{
+		IntervalMin: time.Millisecond,
+		IntervalMax: time.Millisecond,
+	})
+	assert.EqualError(t, err, "Request asyncRequestId could not be processed: Alert does not exist")
+	processingErr, ok := AsAsyncProcessingError(errors.Wrap(err, "Could not create alert"))
+	assert.True(t, ok)
+	assert.Equal(t, "asyncRequestId", processingErr.RequestId)
+	assert.Equal(t, "Alert does not exist", processingErr.Reason)
+
+	_, ok = AsAsyncProcessingError(context.DeadlineExceeded)
+	assert.False(t, ok)
+}
+
+func TestWaitForCompletionMaxWait(t *testing.T) {
+	attemptCount := 0
+	ts := waitTestServer(1000, "", &attemptCount)
+	defer ts.Close()
+
+	ogClient, err := NewOpsGenieClient(&Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	asyncBaseResult := AsyncBaseResult{Client: ogClient}
+	start := time.Now()
+	_, err = asyncBaseResult.WaitForCompletion(context.Background(), &testRequest{MandatoryField: "afield"}, &testStatusResult{}, WaitOptions{
+		MaxWait:     50 * time.Millisecond,
+		IntervalMin: 10 * time.Millisecond,
+		IntervalMax: 10 * time.Millisecond,
+	})
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+	assert.True(t, attemptCount > 1)
+}
@@ -0,0 +1,351 @@
+package configdiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+const redacted = "<redacted>"
+
+// SecretFields are fragments of JSON field names whose values are redacted, matched case insensitively.
+var SecretFields = []string{"apikey", "password", "secret", "token", "privatekey", "credential"}
+
+// contextLines is the number of unchanged lines around the changed lines of a text patch.
+const contextLines = 3
+
+// Change is a change of a single configuration entity, such as a planned change of a sync or a difference found
+// between two exports. Before is nil for created entities and After is nil for deleted ones.
+type Change struct {
+	Action Action
+	Kind   string
+	Name   string
+	Before interface{}
+	After  interface{}
+}
+
+// Operation is a JSON patch operation as defined by RFC 6902.
+type Operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Patch is the JSON rendering of a change.
+type Patch struct {
+	Action     Action      `json:"action"`
+	Kind       string      `json:"kind"`
+	Name       string      `json:"name"`
+	Operations []Operation `json:"operations"`
+}
+
+// FormatText renders the changes as unified diffs of the entities in indented JSON, with secrets redacted. Secrets
+// are compared before they are redacted, so a changed secret shows up as a changed line.
+func FormatText(changes []Change) (string, error) {
+	var buf bytes.Buffer
+	for _, change := range changes {
+		before, after, err := change.documents()
+		if err != nil {
+			return "", err
+		}
+		beforeLines, err := jsonLines(before)
+		if err != nil {
+			return "", err
+		}
+		afterLines, err := jsonLines(after)
+		if err != nil {
+			return "", err
+		}
+		// Redaction keeps the structure of the documents, so their lines match the unredacted ones.
+		shownBeforeLines, err := jsonLines(redact(before, false))
+		if err != nil {
+			return "", err
+		}
+		shownAfterLines, err := jsonLines(redact(after, false))
+		if err != nil {
+			return "", err
+		}
+		name := change.Kind + "/" + change.Name
+		from, to := "a/"+name, "b/"+name
+		if change.Before == nil {
+			from = "/dev/null"
+		}
+		if change.After == nil {
+			to = "/dev/null"
+		}
+		fmt.Fprintf(&buf, "--- %s\n+++ %s\n", from, to)
+		writeHunks(&buf, diffLines(beforeLines, afterLines, shownBeforeLines, shownAfterLines))
+	}
+	return buf.String(), nil
+}
+
+// FormatJSON renders the changes as JSON patches of the entities, with secrets redacted. Secrets are compared before
+// they are redacted, so a changed secret is replaced with a redacted value.
+func FormatJSON(changes []Change) ([]byte, error) {
+	patches := make([]Patch, 0, len(changes))
+	for _, change := range changes {
+		before, after, err := change.documents()
+		if err != nil {
+			return nil, err
+		}
+		patch := Patch{Action: change.Action, Kind: change.Kind, Name: change.Name, Operations: []Operation{}}
+		switch {
+		case change.Before == nil:
+			patch.Operations = append(patch.Operations, Operation{Op: "add", Path: "", Value: after})
+		case change.After == nil:
+			patch.Operations = append(patch.Operations, Operation{Op: "remove", Path: ""})
+		default:
+			patch.Operations = diffValues("", before, after, patch.Operations)
+		}
+		for i, operation := range patch.Operations {
+			patch.Operations[i].Value = redact(operation.Value, isSecretPath(operation.Path))
+		}
+		patches = append(patches, patch)
+	}
+	return json.MarshalIndent(patches, "", "  ")
+}
+
+// documents returns the JSON documents of the entity before and after the change.
+func (c Change) documents() (interface{}, interface{}, error) {
+	before, err := document(c.Before)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Could not render %s %s", c.Kind, c.Name)
+	}
+	after, err := document(c.After)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "Could not render %s %s", c.Kind, c.Name)
+	}
+	return before, after, nil
+}
+
+func document(entity interface{}) (interface{}, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	content, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+// redact returns a copy of the value with the values of secret fields redacted, or with all values redacted when
+// the value is a secret itself. Objects and arrays keep their structure, only the values in them are replaced.
+func redact(value interface{}, secret bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			copied[key] = redact(field, secret || isSecret(key))
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = redact(item, secret)
+		}
+		return copied
+	case nil, string:
+		if secret && v != nil && v != "" {
+			return redacted
+		}
+		return value
+	}
+	if secret {
+		return redacted
+	}
+	return value
+}
+
+func isSecret(field string) bool {
+	field = strings.ToLower(field)
+	for _, fragment := range SecretFields {
+		if strings.Contains(field, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretPath reports whether a JSON pointer goes through a secret field.
+func isSecretPath(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if isSecret(strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonLines(document interface{}) ([]string, error) {
+	if document == nil {
+		return nil, nil
+	}
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(content.String(), "\n"), "\n"), nil
+}
+
+func diffValues(path string, before, after interface{}, operations []Operation) []Operation {
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if !jsonEqual(before, after) {
+			operations = append(operations, Operation{Op: "replace", Path: path, Value: after})
+		}
+		return operations
+	}
+	keys := make([]string, 0, len(beforeMap)+len(afterMap))
+	for key := range beforeMap {
+		keys = append(keys, key)
+	}
+	for key := range afterMap {
+		if _, ok := beforeMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fieldPath := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+		beforeValue, inBefore := beforeMap[key]
+		afterValue, inAfter := afterMap[key]
+		switch {
+		case !inBefore:
+			operations = append(operations, Operation{Op: "add", Path: fieldPath, Value: afterValue})
+		case !inAfter:
+			operations = append(operations, Operation{Op: "remove", Path: fieldPath})
+		default:
+			operations = diffValues(fieldPath, beforeValue, afterValue, operations)
+		}
+	}
+	return operations
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aContent, _ := json.Marshal(a)
+	bContent, _ := json.Marshal(b)
+	return bytes.Equal(aContent, bContent)
+}
+
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// diffLines returns the edit script turning a into b, based on their longest common subsequence. The lines are
+// rendered from shownA and shownB, which hold a line for each line of a and b.
+func diffLines(a, b, shownA, shownB []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{' ', shownA[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', shownA[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', shownB[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// writeHunks writes the changed lines with their context as unified diff hunks.
+func writeHunks(buf *bytes.Buffer, lines []diffLine) {
+	for start := 0; start < len(lines); {
+		if lines[start].kind == ' ' {
+			start++
+			continue
+		}
+		first := start - contextLines
+		if first < 0 {
+			first = 0
+		}
+		last := start
+		for k := start; k < len(lines) && k <= last+2*contextLines; k++ {
+			if lines[k].kind != ' ' {
+				last = k
+			}
+		}
+		end := last + contextLines + 1
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		aStart, bStart := 0, 0
+		for _, line := range lines[:first] {
+			if line.kind != '+' {
+				aStart++
+			}
+			if line.kind != '-' {
+				bStart++
+			}
+		}
+		aCount, bCount := 0, 0
+		for _, line := range lines[first:end] {
+			if line.kind != '+' {
+				aCount++
+			}
+			if line.kind != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+		for _, line := range lines[first:end] {
+			buf.WriteByte(line.kind)
+			buf.WriteString(line.text)
+			buf.WriteByte('\n')
+		}
+		start = end
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,94 @@
+package configdiff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testIntegration struct {
+	Name    string   `json:"name"`
+	ApiKey  string   `json:"apiKey,omitempty"`
+	Enabled bool     `json:"enabled"`
+	Tags    []string `json:"tags,omitempty"`
+}
+
+func TestFormatText(t *testing.T) {
+	text, err := FormatText([]Change{
+		{
+			Action: Update,
+			Kind:   "integration",
+			Name:   "api",
+			Before: testIntegration{Name: "api", ApiKey: "old-key", Enabled: false},
+			After:  testIntegration{Name: "api", ApiKey: "new-key", Enabled: true},
+		},
+		{Action: Delete, Kind: "team", Name: "ops", Before: map[string]string{"name": "ops"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "--- a/integration/api\n"+
+		"+++ b/integration/api\n"+
+		"@@ -1,5 +1,5 @@\n"+
+		" {\n"+
+		"-  \"apiKey\": \"<redacted>\",\n"+
+		"-  \"enabled\": false,\n"+
+		"+  \"apiKey\": \"<redacted>\",\n"+
+		"+  \"enabled\": true,\n"+
+		"   \"name\": \"api\"\n"+
+		" }\n"+
+		"--- a/team/ops\n"+
+		"+++ /dev/null\n"+
+		"@@ -1,3 +0,0 @@\n"+
+		"-{\n"+
+		"-  \"name\": \"ops\"\n"+
+		"-}\n", text)
+	assert.NotContains(t, text, "old-key")
+	assert.NotContains(t, text, "new-key")
+}
+
+func TestFormatJSON(t *testing.T) {
+	content, err := FormatJSON([]Change{
+		{
+			Action: Update,
+			Kind:   "integration",
+			Name:   "api",
+			Before: testIntegration{Name: "api", Enabled: false, Tags: []string{"a"}},
+			After:  testIntegration{Name: "api/v2", ApiKey: "new-key", Enabled: false},
+		},
+		{Action: Create, Kind: "team", Name: "ops", After: map[string]string{"name": "ops", "token": "secret"}},
+	})
+	assert.Nil(t, err)
+
+	var patches []Patch
+	assert.Nil(t, json.Unmarshal(content, &patches))
+	assert.Equal(t, 2, len(patches))
+	assert.Equal(t, []Operation{
+		{Op: "add", Path: "/apiKey", Value: "<redacted>"},
+		{Op: "replace", Path: "/name", Value: "api/v2"},
+		{Op: "remove", Path: "/tags"},
+	}, patches[0].Operations)
+	assert.Equal(t, Create, patches[1].Action)
+	assert.Equal(t, []Operation{{Op: "add", Path: "", Value: map[string]interface{}{"name": "ops", "token": "<redacted>"}}}, patches[1].Operations)
+
+	content, err = FormatJSON([]Change{{
+		Action: Update,
+		Kind:   "integration",
+		Name:   "api",
+		Before: map[string]interface{}{"name": "api", "credentials": map[string]string{"user": "a", "secret": "old"}},
+		After:  map[string]interface{}{"name": "api", "credentials": map[string]string{"user": "a", "secret": "new"}},
+	}})
+	assert.Nil(t, err)
+	assert.NotContains(t, string(content), "new")
+	assert.Nil(t, json.Unmarshal(content, &patches))
+	assert.Equal(t, []Operation{{Op: "replace", Path: "/credentials/secret", Value: "<redacted>"}}, patches[0].Operations)
+}
+
+func TestWriteHunksSeparatesDistantChanges(t *testing.T) {
+	before := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	after := []string{"one", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "twelve"}
+	text, err := FormatText([]Change{{Action: Update, Kind: "list", Name: "numbers", Before: before, After: after}})
+	assert.Nil(t, err)
+	assert.Contains(t, text, "@@ -1,5 +1,5 @@\n [\n-  \"1\",\n+  \"one\",\n")
+	assert.Contains(t, text, "@@ -10,5 +10,5 @@\n")
+	assert.Contains(t, text, "-  \"12\"\n+  \"twelve\"\n ]\n")
+}
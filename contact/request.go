@@ -29,7 +29,7 @@ func (r *CreateRequest) Validate() error {
 }
 
 func (r *CreateRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts"
 }
 
 func (r *CreateRequest) Method() string {
@@ -51,7 +51,7 @@ func (r *GetRequest) Validate() error {
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts/" + r.ContactIdentifier
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts/" + client.PathSegment(r.ContactIdentifier)
 }
 
 func (r *GetRequest) Method() string {
@@ -80,7 +80,7 @@ func (r *UpdateRequest) Validate() error {
 }
 
 func (r *UpdateRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts/" + r.ContactIdentifier
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts/" + client.PathSegment(r.ContactIdentifier)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -101,7 +101,7 @@ func (r *DeleteRequest) Validate() error {
 	return nil
 }
 func (r *DeleteRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts/" + r.ContactIdentifier
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts/" + client.PathSegment(r.ContactIdentifier)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -120,7 +120,7 @@ func (r *ListRequest) Validate() error {
 	return nil
 }
 func (r *ListRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts"
 }
 
 func (r *ListRequest) Method() string {
@@ -141,7 +141,7 @@ func (r *EnableRequest) Validate() error {
 	return nil
 }
 func (r *EnableRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts/" + r.ContactIdentifier + "/enable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts/" + client.PathSegment(r.ContactIdentifier) + "/enable"
 }
 
 func (r *EnableRequest) Method() string {
@@ -162,7 +162,7 @@ func (r *DisableRequest) Validate() error {
 	return nil
 }
 func (r *DisableRequest) ResourcePath() string {
-	return "/v2/users/" + r.UserIdentifier + "/contacts/" + r.ContactIdentifier + "/disable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/contacts/" + client.PathSegment(r.ContactIdentifier) + "/disable"
 }
 
 func (r *DisableRequest) Method() string {
@@ -65,7 +65,7 @@ func (r *GetRequest) Validate() error {
 
 func (r *GetRequest) ResourcePath() string {
 
-	return "/v2/roles/" + r.Identifier
+	return "/v2/roles/" + client.PathSegment(r.Identifier)
 }
 
 func (r *GetRequest) Method() string {
@@ -110,7 +110,7 @@ func (r *UpdateRequest) Validate() error {
 }
 
 func (r *UpdateRequest) ResourcePath() string {
-	return "/v2/roles/" + r.Identifier
+	return "/v2/roles/" + client.PathSegment(r.Identifier)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -144,7 +144,7 @@ func (r *DeleteRequest) Validate() error {
 
 func (r *DeleteRequest) ResourcePath() string {
 
-	return "/v2/roles/" + r.Identifier
+	return "/v2/roles/" + client.PathSegment(r.Identifier)
 }
 
 func (r *DeleteRequest) Method() string {
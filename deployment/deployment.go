@@ -20,6 +20,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	return &Client{client: opsgenieClient}, nil
 }
 
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
+
 func (c *Client) Create(ctx context.Context, req *CreateDeploymentRequest) (*AsyncDeploymentResult, error) {
 
 	result := &AsyncDeploymentResult{}
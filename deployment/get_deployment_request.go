@@ -37,7 +37,7 @@ func (r *GetDeploymentRequest) Validate() error {
 }
 
 func (r *GetDeploymentRequest) ResourcePath() string {
-	return "/v2/deployments/" + r.IdentifierValue
+	return "/v2/deployments/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetDeploymentRequest) Method() string {
@@ -21,7 +21,7 @@ func (r *GetRequestStatusRequest) Validate() error {
 }
 
 func (r *GetRequestStatusRequest) ResourcePath() string {
-	return "/v2/deployments/requests/" + r.RequestId
+	return "/v2/deployments/requests/" + client.PathSegment(r.RequestId)
 }
 
 func (r *GetRequestStatusRequest) Method() string {
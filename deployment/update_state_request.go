@@ -25,7 +25,7 @@ func (r *UpdateDeploymentStateRequest) Validate() error {
 
 func (r *UpdateDeploymentStateRequest) ResourcePath() string {
 
-	return "/v2/deployments/" + r.IdentifierValue + "/updateState"
+	return "/v2/deployments/" + client.PathSegment(r.IdentifierValue) + "/updateState"
 }
 
 func (r *UpdateDeploymentStateRequest) Method() string {
@@ -0,0 +1,140 @@
+package directory
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/user"
+	"github.com/pkg/errors"
+)
+
+const (
+	userPageSize           = 100
+	defaultRefreshInterval = 10 * time.Minute
+)
+
+// Lookup resolves user and team identifiers without calling the API.
+type Lookup interface {
+	UserId(username string) (string, bool)
+	UserByEmail(email string) (user.User, bool)
+	TeamId(name string) (string, bool)
+}
+
+// Index is an in-memory Lookup filled from the users and teams APIs. It is safe for concurrent use and can be
+// refreshed on demand with Refresh or periodically with Start.
+type Index struct {
+	userClient   *user.Client
+	teamClient   *team.Client
+	ErrorHandler func(err error)
+	// Clock schedules refreshes and records refresh times. client.SystemClock is used when it is not set.
+	Clock client.Clock
+
+	mux         sync.RWMutex
+	users       map[string]user.User
+	teams       map[string]string
+	refreshedAt time.Time
+}
+
+func NewIndex(userClient *user.Client, teamClient *team.Client) (*Index, error) {
+	if userClient == nil || teamClient == nil {
+		return nil, errors.New("User and team clients cannot be empty.")
+	}
+	return &Index{
+		userClient: userClient,
+		teamClient: teamClient,
+		users:      make(map[string]user.User),
+		teams:      make(map[string]string),
+	}, nil
+}
+
+// Refresh reloads all users and teams. The index keeps serving the previous data if loading fails.
+func (i *Index) Refresh(ctx context.Context) error {
+	users := make(map[string]user.User)
+	for offset := 0; ; {
+		result, err := i.userClient.List(ctx, &user.ListRequest{Limit: userPageSize, Offset: offset})
+		if err != nil {
+			return err
+		}
+		for _, u := range result.Users {
+			users[strings.ToLower(u.Username)] = u
+		}
+		offset += len(result.Users)
+		if len(result.Users) == 0 || offset >= result.TotalCount {
+			break
+		}
+	}
+
+	teamResult, err := i.teamClient.List(ctx, &team.ListTeamRequest{})
+	if err != nil {
+		return err
+	}
+	teams := make(map[string]string)
+	for _, t := range teamResult.Teams {
+		teams[strings.ToLower(t.Name)] = t.Id
+	}
+
+	i.mux.Lock()
+	i.users = users
+	i.teams = teams
+	i.refreshedAt = i.clock().Now()
+	i.mux.Unlock()
+	return nil
+}
+
+// Start refreshes the index immediately and then on every interval until the context is done, every 10 minutes
+// when the interval is not positive. Refresh errors are passed to ErrorHandler.
+func (i *Index) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	go func() {
+		for {
+			if err := i.Refresh(ctx); err != nil && i.ErrorHandler != nil && ctx.Err() == nil {
+				i.ErrorHandler(err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-i.clock().After(interval):
+			}
+		}
+	}()
+}
+
+func (i *Index) clock() client.Clock {
+	if i.Clock == nil {
+		return client.SystemClock
+	}
+	return i.Clock
+}
+
+// RefreshedAt returns when the index was last loaded successfully.
+func (i *Index) RefreshedAt() time.Time {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+	return i.refreshedAt
+}
+
+func (i *Index) UserId(username string) (string, bool) {
+	u, ok := i.UserByEmail(username)
+	return u.Id, ok
+}
+
+// UserByEmail returns the user with the given email. OpsGenie usernames are email addresses.
+func (i *Index) UserByEmail(email string) (user.User, bool) {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+	u, ok := i.users[strings.ToLower(email)]
+	return u, ok
+}
+
+func (i *Index) TeamId(name string) (string, bool) {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+	id, ok := i.teams[strings.ToLower(name)]
+	return id, ok
+}
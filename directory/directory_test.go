@@ -0,0 +1,120 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	teamName := "Ops"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v2/users/" && r.URL.Query().Get("offset") == "":
+			fmt.Fprint(w, `{"data": [{"id": "u1", "username": "John@example.com"}], "totalCount": 2, "took": 0.1, "requestId": "r1"}`)
+		case r.URL.Path == "/v2/users/" && r.URL.Query().Get("offset") == "1":
+			fmt.Fprint(w, `{"data": [{"id": "u2", "username": "jane@example.com"}], "totalCount": 2, "took": 0.1, "requestId": "r2"}`)
+		case r.URL.Path == "/v2/teams":
+			fmt.Fprintf(w, `{"data": [{"id": "t1", "name": "%s"}], "took": 0.1, "requestId": "r3"}`, teamName)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	userClient, err := user.NewClient(config)
+	assert.Nil(t, err)
+	teamClient, err := team.NewClient(config)
+	assert.Nil(t, err)
+
+	index, err := NewIndex(userClient, teamClient)
+	assert.Nil(t, err)
+	var lookup Lookup = index
+
+	_, ok := lookup.TeamId("ops")
+	assert.False(t, ok)
+
+	err = index.Refresh(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, index.RefreshedAt().IsZero())
+
+	id, ok := lookup.UserId("john@example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "u1", id)
+	jane, ok := lookup.UserByEmail("Jane@Example.com")
+	assert.True(t, ok)
+	assert.Equal(t, "u2", jane.Id)
+	id, ok = lookup.TeamId("ops")
+	assert.True(t, ok)
+	assert.Equal(t, "t1", id)
+
+	teamName = "Platform"
+	err = index.Refresh(context.Background())
+	assert.Nil(t, err)
+	_, ok = lookup.TeamId("ops")
+	assert.False(t, ok)
+	id, _ = lookup.TeamId("platform")
+	assert.Equal(t, "t1", id)
+}
+
+func TestIndexStartWithoutInterval(t *testing.T) {
+	var mux sync.Mutex
+	teamRequests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/teams" {
+			mux.Lock()
+			teamRequests++
+			mux.Unlock()
+		}
+		fmt.Fprint(w, `{"data": [], "totalCount": 0, "took": 0.1, "requestId": "r1"}`)
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	userClient, err := user.NewClient(config)
+	assert.Nil(t, err)
+	teamClient, err := team.NewClient(config)
+	assert.Nil(t, err)
+	index, err := NewIndex(userClient, teamClient)
+	assert.Nil(t, err)
+	clock := client.NewManualClock(time.Now())
+	index.Clock = clock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	index.Start(ctx, 0)
+	for clock.Waiters() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	mux.Lock()
+	assert.Equal(t, 1, teamRequests)
+	mux.Unlock()
+
+	clock.Advance(defaultRefreshInterval)
+	for {
+		mux.Lock()
+		requests := teamRequests
+		mux.Unlock()
+		if requests == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
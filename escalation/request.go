@@ -83,7 +83,7 @@ func (r *GetRequest) Method() string {
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v2/escalations/" + r.Identifier
+	return "/v2/escalations/" + client.PathSegment(r.Identifier)
 }
 
 func (r *GetRequest) RequestParams() map[string]string {
@@ -123,7 +123,7 @@ func (r *UpdateRequest) Validate() error {
 }
 
 func (r *UpdateRequest) ResourcePath() string {
-	return "/v2/escalations/" + r.Identifier
+	return "/v2/escalations/" + client.PathSegment(r.Identifier)
 }
 
 func (r *UpdateRequest) RequestParams() map[string]string {
@@ -162,7 +162,7 @@ func (r *DeleteRequest) Method() string {
 }
 
 func (r *DeleteRequest) ResourcePath() string {
-	return "/v2/escalations/" + r.Identifier
+	return "/v2/escalations/" + client.PathSegment(r.Identifier)
 }
 
 func (r *DeleteRequest) RequestParams() map[string]string {
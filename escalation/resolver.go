@@ -0,0 +1,205 @@
+package escalation
+
+import (
+	"context"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/schedule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/pkg/errors"
+)
+
+// Resolver expands the rules of escalations into the users they would notify right now.
+type Resolver struct {
+	escalationClient *Client
+	scheduleClient   *schedule.Client
+	teamClient       *team.Client
+}
+
+// ResolvedUser is a user notified by a step. Users resolved from schedules only carry a username.
+type ResolvedUser struct {
+	Id       string
+	Username string
+}
+
+// ResolvedStep is a rule of an escalation together with the users it would notify after Delay.
+type ResolvedStep struct {
+	Rule  Rule
+	Delay time.Duration
+	Users []ResolvedUser
+	// Warnings explain why the users of the step may be incomplete.
+	Warnings []string
+}
+
+func NewResolver(escalationClient *Client, scheduleClient *schedule.Client, teamClient *team.Client) (*Resolver, error) {
+	if escalationClient == nil || scheduleClient == nil || teamClient == nil {
+		return nil, errors.New("Escalation, schedule and team clients cannot be empty.")
+	}
+	return &Resolver{
+		escalationClient: escalationClient,
+		scheduleClient:   scheduleClient,
+		teamClient:       teamClient,
+	}, nil
+}
+
+// Resolve returns a step for every rule of the escalation, in the order of the rules.
+func (r *Resolver) Resolve(ctx context.Context, req *GetRequest) ([]ResolvedStep, error) {
+	result, err := r.escalationClient.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]ResolvedStep, 0, len(result.Rules))
+	for _, rule := range result.Rules {
+		step := ResolvedStep{Rule: rule, Delay: ruleDelay(rule.Delay)}
+		if err := r.resolveRecipient(ctx, &step, rule.Recipient, rule.NotifyType); err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func (r *Resolver) resolveRecipient(ctx context.Context, step *ResolvedStep, recipient og.Participant, notifyType og.NotifyType) error {
+	switch recipient.Type {
+	case og.User:
+		step.Users = append(step.Users, ResolvedUser{Id: recipient.Id, Username: recipient.Username})
+		return nil
+	case og.Schedule:
+		return r.resolveSchedule(ctx, step, recipient, notifyType)
+	case og.Team:
+		return r.resolveTeam(ctx, step, recipient, notifyType)
+	default:
+		step.Warnings = append(step.Warnings, "Recipients of type "+string(recipient.Type)+" cannot be resolved.")
+		return nil
+	}
+}
+
+func (r *Resolver) resolveSchedule(ctx context.Context, step *ResolvedStep, recipient og.Participant, notifyType og.NotifyType) error {
+	identifierType, identifier := schedule.Id, recipient.Id
+	if identifier == "" {
+		identifierType, identifier = schedule.Name, recipient.Name
+	}
+	flat := true
+
+	var usernames []string
+	switch notifyType {
+	case og.Next:
+		result, err := r.scheduleClient.GetNextOnCall(ctx, &schedule.GetNextOnCallsRequest{
+			Flat:                   &flat,
+			ScheduleIdentifierType: identifierType,
+			ScheduleIdentifier:     identifier,
+		})
+		if err != nil {
+			return err
+		}
+		usernames = result.ExactNextOnCallParticipants
+	case og.Previous:
+		step.Warnings = append(step.Warnings, "Previous on-call users of schedule "+identifier+" cannot be resolved.")
+		return nil
+	default:
+		result, err := r.scheduleClient.GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+			Flat:                   &flat,
+			ScheduleIdentifierType: identifierType,
+			ScheduleIdentifier:     identifier,
+		})
+		if err != nil {
+			return err
+		}
+		usernames = result.OnCallRecipients
+	}
+
+	if len(usernames) == 0 {
+		step.Warnings = append(step.Warnings, "Nobody is on call for schedule "+identifier+".")
+	}
+	for _, username := range usernames {
+		step.Users = append(step.Users, ResolvedUser{Username: username})
+	}
+	return nil
+}
+
+func (r *Resolver) resolveTeam(ctx context.Context, step *ResolvedStep, recipient og.Participant, notifyType og.NotifyType) error {
+	identifierType, identifier := team.Id, recipient.Id
+	if identifier == "" {
+		identifierType, identifier = team.Name, recipient.Name
+	}
+
+	if notifyType == og.Default || notifyType == "" {
+		return r.resolveTeamDefault(ctx, step, identifierType, identifier)
+	}
+
+	result, err := r.teamClient.Get(ctx, &team.GetTeamRequest{IdentifierType: identifierType, IdentifierValue: identifier})
+	if err != nil {
+		return err
+	}
+	for _, member := range result.Members {
+		if (notifyType == og.Users && member.Role != "user") || (notifyType == og.Admins && member.Role != "admin") {
+			continue
+		}
+		step.Users = append(step.Users, ResolvedUser{Id: member.User.ID, Username: member.User.Username})
+	}
+	if notifyType == og.Random {
+		step.Warnings = append(step.Warnings, "Only one random member of team "+identifier+" is notified.")
+	}
+	return nil
+}
+
+// resolveTeamDefault follows the default routing rule of the team. Only the first rule of an escalation the rule
+// notifies is resolved, as later rules are delayed relative to the team being notified.
+func (r *Resolver) resolveTeamDefault(ctx context.Context, step *ResolvedStep, identifierType team.Identifier, identifier string) error {
+	rules, err := r.teamClient.ListRoutingRules(ctx, &team.ListRoutingRulesRequest{
+		TeamIdentifierType:  identifierType,
+		TeamIdentifierValue: identifier,
+	})
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules.RoutingRules {
+		if !rule.IsDefault {
+			continue
+		}
+		switch rule.Notify.Type {
+		case team.ScheduleNotifyType:
+			return r.resolveSchedule(ctx, step, og.Participant{Type: og.Schedule, Id: rule.Notify.Id, Name: rule.Notify.Name}, og.Default)
+		case team.EscalationNotifyType:
+			getRequest := &GetRequest{IdentifierType: Id, Identifier: rule.Notify.Id}
+			if rule.Notify.Id == "" {
+				getRequest = &GetRequest{IdentifierType: Name, Identifier: rule.Notify.Name}
+			}
+			result, err := r.escalationClient.Get(ctx, getRequest)
+			if err != nil {
+				return err
+			}
+			if len(result.Rules) == 0 {
+				break
+			}
+			if len(result.Rules) > 1 {
+				step.Warnings = append(step.Warnings, "Only the first rule of escalation "+result.Name+" of team "+identifier+" is resolved.")
+			}
+			first := result.Rules[0]
+			if first.Recipient.Type == og.Team {
+				step.Warnings = append(step.Warnings, "Team "+identifier+" escalates to another team, which is not resolved.")
+				return nil
+			}
+			return r.resolveRecipient(ctx, step, first.Recipient, first.NotifyType)
+		}
+		break
+	}
+	step.Warnings = append(step.Warnings, "The default routing rule of team "+identifier+" does not notify anyone.")
+	return nil
+}
+
+func ruleDelay(d EscalationDelay) time.Duration {
+	amount := time.Duration(d.TimeAmount)
+	switch d.TimeUnit {
+	case og.Hours:
+		return amount * time.Hour
+	case og.Days:
+		return amount * 24 * time.Hour
+	case og.Weeks:
+		return amount * 7 * 24 * time.Hour
+	default:
+		return amount * time.Minute
+	}
+}
@@ -0,0 +1,75 @@
+package escalation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/schedule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolver(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/escalations/ops-escalation":
+			fmt.Fprint(w, `{"data": {"id": "e1", "name": "ops-escalation", "rules": [
+				{"condition": "if-not-acked", "notifyType": "default", "recipient": {"type": "schedule", "name": "ops-schedule"}, "delay": {"timeAmount": 0}},
+				{"condition": "if-not-acked", "notifyType": "next", "recipient": {"type": "schedule", "name": "ops-schedule"}, "delay": {"timeAmount": 5}},
+				{"condition": "if-not-acked", "notifyType": "admins", "recipient": {"type": "team", "name": "ops"}, "delay": {"timeAmount": 1, "timeUnit": "hours"}},
+				{"condition": "if-not-acked", "notifyType": "default", "recipient": {"type": "team", "name": "dev"}, "delay": {"timeAmount": 2, "timeUnit": "hours"}},
+				{"condition": "if-not-acked", "notifyType": "default", "recipient": {"type": "user", "username": "cto@example.com"}, "delay": {"timeAmount": 3, "timeUnit": "hours"}}
+			]}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/schedules/ops-schedule/on-calls":
+			assert.Equal(t, "true", r.URL.Query().Get("flat"))
+			fmt.Fprint(w, `{"data": {"onCallRecipients": ["john@example.com"]}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/schedules/ops-schedule/next-on-calls":
+			fmt.Fprint(w, `{"data": {"exactNextOnCallParticipants": ["jane@example.com"]}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/schedules/dev-schedule/on-calls":
+			fmt.Fprint(w, `{"data": {"onCallRecipients": []}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/teams/ops":
+			fmt.Fprint(w, `{"data": {"id": "t1", "name": "ops", "members": [
+				{"user": {"id": "u1", "username": "john@example.com"}, "role": "user"},
+				{"user": {"id": "u2", "username": "boss@example.com"}, "role": "admin"}
+			]}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/teams/dev/routing-rules":
+			fmt.Fprint(w, `{"data": [{"name": "default", "isDefault": true, "notify": {"type": "schedule", "name": "dev-schedule"}}], "took": 0.1, "requestId": "rId"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	escalationClient, _ := NewClient(config)
+	scheduleClient, _ := schedule.NewClient(config)
+	teamClient, _ := team.NewClient(config)
+
+	_, err := NewResolver(escalationClient, nil, teamClient)
+	assert.EqualError(t, err, "Escalation, schedule and team clients cannot be empty.")
+
+	resolver, err := NewResolver(escalationClient, scheduleClient, teamClient)
+	assert.Nil(t, err)
+	steps, err := resolver.Resolve(context.Background(), &GetRequest{IdentifierType: Name, Identifier: "ops-escalation"})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(steps))
+
+	assert.Equal(t, []ResolvedUser{{Username: "john@example.com"}}, steps[0].Users)
+	assert.Equal(t, []ResolvedUser{{Username: "jane@example.com"}}, steps[1].Users)
+	assert.Equal(t, 5*time.Minute, steps[1].Delay)
+	assert.Equal(t, []ResolvedUser{{Id: "u2", Username: "boss@example.com"}}, steps[2].Users)
+	assert.Equal(t, time.Hour, steps[2].Delay)
+	assert.Empty(t, steps[3].Users)
+	assert.Equal(t, []string{"Nobody is on call for schedule dev-schedule."}, steps[3].Warnings)
+	assert.Equal(t, []ResolvedUser{{Username: "cto@example.com"}}, steps[4].Users)
+}
@@ -72,7 +72,7 @@ func (r *GetRequest) Validate() error {
 
 func (r *GetRequest) ResourcePath() string {
 
-	return "/v2/forwarding-rules/" + r.IdentifierValue
+	return "/v2/forwarding-rules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetRequest) Method() string {
@@ -130,7 +130,7 @@ func (r *UpdateRequest) Validate() error {
 
 func (r *UpdateRequest) ResourcePath() string {
 
-	return "/v2/forwarding-rules/" + r.IdentifierValue
+	return "/v2/forwarding-rules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -166,7 +166,7 @@ func (r *DeleteRequest) Validate() error {
 
 func (r *DeleteRequest) ResourcePath() string {
 
-	return "/v2/forwarding-rules/" + r.IdentifierValue
+	return "/v2/forwarding-rules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -17,6 +17,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	return &Client{opsgenieClient}, nil
 }
 
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
+
 func (c *Client) Ping(context context.Context, heartbeatName string) (*PingResult, error) {
 	pingResult := &PingResult{}
 	request := &pingRequest{HeartbeatName: heartbeatName}
@@ -24,6 +30,7 @@ func (c *Client) Ping(context context.Context, heartbeatName string) (*PingResul
 	if err != nil {
 		return nil, err
 	}
+	pingResult.setOutcome()
 	return pingResult, nil
 }
 
@@ -94,3 +101,20 @@ func (c *Client) Delete(context context.Context, heartbeatName string) (*DeleteR
 	}
 	return deleteResult, nil
 }
+
+// CompilePing compiles a ping of the heartbeat for PingFromTemplate. The name may be a client.Placeholder to ping
+// several heartbeats with one template.
+func (c *Client) CompilePing(heartbeatName string) (*client.RequestTemplate, error) {
+	return c.client.CompileTemplate(&pingRequest{HeartbeatName: heartbeatName})
+}
+
+// PingFromTemplate pings the heartbeat of a template compiled with CompilePing.
+func (c *Client) PingFromTemplate(context context.Context, template *client.RequestTemplate, values map[string]string) (*PingResult, error) {
+	pingResult := &PingResult{}
+	err := c.client.ExecTemplate(context, template, values, pingResult)
+	if err != nil {
+		return nil, err
+	}
+	pingResult.setOutcome()
+	return pingResult, nil
+}
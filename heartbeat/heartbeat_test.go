@@ -1,10 +1,19 @@
 package heartbeat
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func TestAddRequest_Validate(t *testing.T) {
@@ -35,3 +44,84 @@ func TestDeleteRequest_Validate(t *testing.T) {
 
 	assert.Equal(t, err.Error(), errors.New("HeartbeatName cannot be empty").Error())
 }
+
+func TestResourcePathEscapesName(t *testing.T) {
+	assert.Equal(t, "/v2/heartbeats/db%2Fprimary%20eu/ping", pingRequest{HeartbeatName: "db/primary eu"}.ResourcePath())
+	assert.Equal(t, "/v2/heartbeats/db%2Fprimary%20eu", UpdateRequest{Name: "db/primary eu"}.ResourcePath())
+}
+
+func TestPingFromTemplate(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"result": "PONG - Heartbeat received", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	heartbeatClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = heartbeatClient.CompilePing("")
+	assert.Equal(t, "HeartbeatName cannot be empty", err.Error())
+	template, err := heartbeatClient.CompilePing(client.Placeholder("name"))
+	assert.Nil(t, err)
+
+	for _, name := range []string{"db/primary", "cache"} {
+		result, err := heartbeatClient.PingFromTemplate(context.Background(), template, map[string]string{"name": name})
+		assert.Nil(t, err)
+		assert.Equal(t, "PONG - Heartbeat received", result.Message)
+	}
+	assert.Equal(t, []string{"/v2/heartbeats/db%2Fprimary/ping", "/v2/heartbeats/cache/ping"}, paths)
+}
+
+func TestPingOutcome(t *testing.T) {
+	var mux sync.Mutex
+	responses := map[string][]int{
+		"/v2/heartbeats/ok/ping":        {http.StatusAccepted},
+		"/v2/heartbeats/throttled/ping": {http.StatusTooManyRequests, http.StatusAccepted},
+		"/v2/heartbeats/missing/ping":   {http.StatusNotFound},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		status := responses[r.URL.Path][0]
+		if len(responses[r.URL.Path]) > 1 {
+			responses[r.URL.Path] = responses[r.URL.Path][1:]
+		}
+		mux.Unlock()
+		w.Header().Set("Date", "Fri, 16 Oct 2026 10:00:00 GMT")
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"result": "PONG - Heartbeat received", "message": "not found", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	heartbeatClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+	})
+	assert.Nil(t, err)
+
+	result, err := heartbeatClient.Ping(context.Background(), "ok")
+	assert.Nil(t, err)
+	assert.Equal(t, PingAccepted, result.Outcome)
+	assert.Equal(t, time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC), result.ServerTime)
+
+	result, err = heartbeatClient.Ping(context.Background(), "throttled")
+	assert.Nil(t, err)
+	assert.Equal(t, PingThrottled, result.Outcome)
+	assert.Equal(t, 1, result.RetryCount)
+	assert.Equal(t, 1, result.ThrottledCount)
+
+	results := make(chan *PingResult)
+	heartbeatClient.PingAsync(context.Background(), "missing", func(result *PingResult) {
+		results <- result
+	})
+	result = <-results
+	assert.Equal(t, PingDropped, result.Outcome)
+	assert.NotNil(t, result.Err)
+}
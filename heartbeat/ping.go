@@ -0,0 +1,40 @@
+package heartbeat
+
+import (
+	"context"
+)
+
+// PingOutcome is the outcome of a ping.
+type PingOutcome string
+
+const (
+	// PingAccepted pings were accepted at the first attempt, or after retries that were not throttled.
+	PingAccepted PingOutcome = "accepted"
+	// PingThrottled pings were rejected with 429 responses and accepted on a retry.
+	PingThrottled PingOutcome = "throttled"
+	// PingDropped pings were not accepted; only PingAsync reports them as results instead of errors.
+	PingDropped PingOutcome = "dropped"
+)
+
+func (r *PingResult) setOutcome() {
+	r.Outcome = PingAccepted
+	if r.ThrottledCount > 0 {
+		r.Outcome = PingThrottled
+	}
+	r.ServerTime = r.Date
+}
+
+// PingAsync pings the heartbeat in the background, for jobs that must neither wait for nor fail on their liveness
+// signal. done, if not nil, is called with the result; pings that failed are reported with the PingDropped outcome
+// and their error in Err.
+func (c *Client) PingAsync(ctx context.Context, heartbeatName string, done func(result *PingResult)) {
+	go func() {
+		result, err := c.Ping(ctx, heartbeatName)
+		if err != nil {
+			result = &PingResult{Outcome: PingDropped, Err: err}
+		}
+		if done != nil {
+			done(result)
+		}
+	}()
+}
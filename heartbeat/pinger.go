@@ -0,0 +1,90 @@
+package heartbeat
+
+import (
+	"context"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+)
+
+const (
+	pingerSubsystem     = "heartbeat-pinger"
+	defaultMinBackoff   = time.Second
+	defaultPingInterval = time.Minute
+)
+
+// Pinger pings a heartbeat on an interval. Failed pings are retried with an exponential backoff that starts at
+// MinBackoff and is capped at the interval. Every ping publishes a client.SubsystemMetric.
+type Pinger struct {
+	client        *Client
+	heartbeatName string
+	interval      time.Duration
+	MinBackoff    time.Duration
+	ErrorHandler  func(err error)
+}
+
+// NewPinger creates a pinger pinging on the interval, or every minute when the interval is not positive.
+func NewPinger(client *Client, heartbeatName string, interval time.Duration) *Pinger {
+	if interval <= 0 {
+		interval = defaultPingInterval
+	}
+	return &Pinger{
+		client:        client,
+		heartbeatName: heartbeatName,
+		interval:      interval,
+		MinBackoff:    defaultMinBackoff,
+	}
+}
+
+// Start pings the heartbeat immediately and keeps pinging until the context is done.
+func (p *Pinger) Start(ctx context.Context) {
+	go func() {
+		var backoff time.Duration
+		for {
+			backoff = p.tick(ctx, backoff)
+			wait := p.interval
+			if backoff > 0 {
+				wait = backoff
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-p.client.client.Clock().After(wait):
+			}
+		}
+	}()
+}
+
+// tick pings the heartbeat once and returns the backoff to wait before the next attempt, zero after a success.
+func (p *Pinger) tick(ctx context.Context, backoff time.Duration) time.Duration {
+	clock := p.client.client.Clock()
+	start := clock.Now()
+	_, err := p.client.Ping(ctx, p.heartbeatName)
+	metric := &client.SubsystemMetric{
+		Subsystem: pingerSubsystem,
+		Name:      p.heartbeatName,
+		Event:     client.TickEvent,
+		Duration:  int64(clock.Now().Sub(start) / time.Millisecond),
+	}
+	if err == nil {
+		p.client.client.PublishSubsystemMetric(ctx, metric)
+		return 0
+	}
+
+	if backoff == 0 {
+		backoff = p.MinBackoff
+	} else {
+		backoff *= 2
+	}
+	if backoff > p.interval {
+		backoff = p.interval
+	}
+	metric.Event = client.FailureEvent
+	metric.Error = err
+	metric.Backoff = backoff
+	p.client.client.PublishSubsystemMetric(ctx, metric)
+	if p.ErrorHandler != nil && ctx.Err() == nil {
+		p.ErrorHandler(err)
+	}
+	return backoff
+}
@@ -0,0 +1,67 @@
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingerTick(t *testing.T) {
+	failing := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "invalid", "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"result": "PONG - Heartbeat received", "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	var metrics []*client.SubsystemMetric
+	subscriber := client.MetricSubscriber{Process: func(metric client.Metric) interface{} {
+		if m, ok := metric.(*client.SubsystemMetric); ok && m.Name == "pinger-test" {
+			metrics = append(metrics, m)
+		}
+		return nil
+	}}
+	subscriber.Register(client.SUBSYSTEM)
+
+	heartbeatClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	pinger := NewPinger(heartbeatClient, "pinger-test", 5*time.Second)
+	backoff := pinger.tick(context.Background(), 0)
+	assert.Equal(t, time.Second, backoff)
+	backoff = pinger.tick(context.Background(), backoff)
+	assert.Equal(t, 2*time.Second, backoff)
+	backoff = pinger.tick(context.Background(), 4*time.Second)
+	assert.Equal(t, 5*time.Second, backoff)
+
+	failing = false
+	backoff = pinger.tick(context.Background(), backoff)
+	assert.Equal(t, time.Duration(0), backoff)
+
+	assert.Equal(t, 4, len(metrics))
+	assert.Equal(t, client.FailureEvent, metrics[0].Event)
+	assert.Equal(t, time.Second, metrics[0].Backoff)
+	assert.NotNil(t, metrics[0].Error)
+	assert.Equal(t, client.TickEvent, metrics[3].Event)
+	assert.Nil(t, metrics[3].Error)
+}
+
+func TestPingerDefaultInterval(t *testing.T) {
+	assert.Equal(t, defaultPingInterval, NewPinger(&Client{}, "pinger-test", 0).interval)
+	assert.Equal(t, defaultPingInterval, NewPinger(&Client{}, "pinger-test", -time.Second).interval)
+}
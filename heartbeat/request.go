@@ -25,7 +25,7 @@ func (r pingRequest) Validate() error {
 }
 
 func (r pingRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.HeartbeatName + "/ping"
+	return "/v2/heartbeats/" + client.PathSegment(r.HeartbeatName) + "/ping"
 }
 
 func (r pingRequest) Method() string {
@@ -42,7 +42,7 @@ func (r getRequest) Validate() error {
 }
 
 func (r getRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.HeartbeatName
+	return "/v2/heartbeats/" + client.PathSegment(r.HeartbeatName)
 }
 
 func (r getRequest) Method() string {
@@ -92,7 +92,7 @@ func (r UpdateRequest) Validate() error {
 }
 
 func (r UpdateRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.Name
+	return "/v2/heartbeats/" + client.PathSegment(r.Name)
 }
 
 func (r UpdateRequest) Method() string {
@@ -154,7 +154,7 @@ func (r enableRequest) Validate() error {
 }
 
 func (r enableRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.heartbeatName + "/enable"
+	return "/v2/heartbeats/" + client.PathSegment(r.heartbeatName) + "/enable"
 }
 
 func (r enableRequest) Method() string {
@@ -174,7 +174,7 @@ func (r disableRequest) Validate() error {
 }
 
 func (r disableRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.heartbeatName + "/disable"
+	return "/v2/heartbeats/" + client.PathSegment(r.heartbeatName) + "/disable"
 }
 
 func (r disableRequest) Method() string {
@@ -191,7 +191,7 @@ func (r deleteRequest) Validate() error {
 }
 
 func (r deleteRequest) ResourcePath() string {
-	return "/v2/heartbeats/" + r.HeartbeatName
+	return "/v2/heartbeats/" + client.PathSegment(r.HeartbeatName)
 }
 
 func (r deleteRequest) Method() string {
@@ -1,6 +1,8 @@
 package heartbeat
 
 import (
+	"time"
+
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
 )
@@ -20,14 +22,21 @@ type Heartbeat struct {
 
 type HeartbeatInfo struct {
 	client.ResultMetadata
-	Name    string `json:"name"`
-	Enabled bool   `json:"enabled"`
-	Expired bool   `json:"expired"`
+	Name      string   `json:"name"`
+	Enabled   bool     `json:"enabled"`
+	Expired   bool     `json:"expired"`
+	AlertTags []string `json:"alertTags,omitempty"`
 }
 
 type PingResult struct {
 	client.ResultMetadata
 	Message string `json:"result"`
+	// Outcome tells whether the ping was accepted, accepted after being throttled, or dropped by PingAsync.
+	Outcome PingOutcome `json:"-"`
+	// ServerTime is the time Opsgenie received the ping at, zero if the response carried no Date header.
+	ServerTime time.Time `json:"-"`
+	// Err is the error a ping dropped by PingAsync failed with.
+	Err error `json:"-"`
 }
 
 type GetResult struct {
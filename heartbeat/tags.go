@@ -0,0 +1,66 @@
+package heartbeat
+
+import (
+	"context"
+	"strings"
+)
+
+// HasTags reports whether the alerts of the heartbeat are tagged with all of the tags.
+func (h Heartbeat) HasTags(tags ...string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, alertTag := range h.AlertTags {
+			if alertTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// TagValue returns the value of the first "key:value" alert tag with the key, e.g. "prod" of "env:prod".
+func (h Heartbeat) TagValue(key string) (string, bool) {
+	for _, tag := range h.AlertTags {
+		if strings.HasPrefix(tag, key+":") {
+			return strings.TrimPrefix(tag, key+":"), true
+		}
+	}
+	return "", false
+}
+
+// WithTags returns the heartbeats tagged with all of the tags.
+func (r *ListResult) WithTags(tags ...string) []Heartbeat {
+	var heartbeats []Heartbeat
+	for _, heartbeat := range r.Heartbeats {
+		if heartbeat.HasTags(tags...) {
+			heartbeats = append(heartbeats, heartbeat)
+		}
+	}
+	return heartbeats
+}
+
+// GroupByTag groups the heartbeats by the value of their "key:value" alert tag with the key. Heartbeats without
+// such a tag are grouped under "".
+func (r *ListResult) GroupByTag(key string) map[string][]Heartbeat {
+	groups := make(map[string][]Heartbeat)
+	for _, heartbeat := range r.Heartbeats {
+		value, _ := heartbeat.TagValue(key)
+		groups[value] = append(groups[value], heartbeat)
+	}
+	return groups
+}
+
+// ListWithTags lists the heartbeats tagged with all of the tags. The API cannot filter heartbeats, so all of them are
+// fetched and filtered by the client.
+func (c *Client) ListWithTags(context context.Context, tags ...string) (*ListResult, error) {
+	listResult, err := c.List(context)
+	if err != nil {
+		return nil, err
+	}
+	listResult.Heartbeats = listResult.WithTags(tags...)
+	return listResult, nil
+}
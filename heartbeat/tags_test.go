@@ -0,0 +1,44 @@
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListWithTags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"heartbeats": [
+			{"name": "db", "alertTags": ["env:prod", "db"]},
+			{"name": "cache", "alertTags": ["env:staging"]},
+			{"name": "queue", "alertTags": ["env:prod"]},
+			{"name": "legacy"}]}, "took": 0.1, "requestId": "rId"}`)
+	}))
+	defer ts.Close()
+
+	heartbeatClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	result, err := heartbeatClient.ListWithTags(context.Background(), "env:prod")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Heartbeats))
+	assert.Equal(t, "db", result.Heartbeats[0].Name)
+	assert.Equal(t, "queue", result.Heartbeats[1].Name)
+
+	result, err = heartbeatClient.List(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result.WithTags("env:prod", "db")))
+	groups := result.GroupByTag("env")
+	assert.Equal(t, 2, len(groups["prod"]))
+	assert.Equal(t, "cache", groups["staging"][0].Name)
+	assert.Equal(t, "legacy", groups[""][0].Name)
+}
@@ -0,0 +1,94 @@
+package heartbeat
+
+import (
+	"context"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/pkg/errors"
+)
+
+type TransferOptions struct {
+	// DryRun only reports the heartbeats that would be transferred, without updating them.
+	DryRun bool
+}
+
+// TransferResult is the outcome of transferring a single heartbeat. Err is set when it could not be read, updated
+// or verified, in which case the heartbeat may still be owned by PreviousOwner.
+type TransferResult struct {
+	Name          string
+	PreviousOwner og.OwnerTeam
+	// Transferred is false when the heartbeat is already owned by the team or when running dry.
+	Transferred bool
+	Err         error
+}
+
+// TransferOwnership reassigns the heartbeats to the owner team. Every heartbeat is read, updated with its current
+// settings and the new owner, then read again to verify the owner changed. A failing heartbeat does not stop the
+// others from being transferred, its error is reported in its result.
+func (c *Client) TransferOwnership(ctx context.Context, heartbeatNames []string, owner og.OwnerTeam, options TransferOptions) ([]TransferResult, error) {
+	if owner.Id == "" && owner.Name == "" {
+		return nil, errors.New("Owner team cannot be empty.")
+	}
+	if len(heartbeatNames) == 0 {
+		return nil, errors.New("Heartbeat names cannot be empty.")
+	}
+
+	results := make([]TransferResult, 0, len(heartbeatNames))
+	for _, name := range heartbeatNames {
+		results = append(results, c.transfer(ctx, name, owner, options))
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+	}
+	return results, nil
+}
+
+func (c *Client) transfer(ctx context.Context, name string, owner og.OwnerTeam, options TransferOptions) TransferResult {
+	result := TransferResult{Name: name}
+	current, err := c.Get(ctx, name)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.PreviousOwner = current.OwnerTeam
+	if sameTeam(current.OwnerTeam, owner) || options.DryRun {
+		return result
+	}
+
+	enabled := current.Enabled
+	_, err = c.Update(ctx, &UpdateRequest{
+		Name:          current.Name,
+		Description:   current.Description,
+		Interval:      current.Interval,
+		IntervalUnit:  Unit(current.IntervalUnit),
+		Enabled:       &enabled,
+		OwnerTeam:     owner,
+		AlertMessage:  current.AlertMessage,
+		AlertTag:      current.AlertTags,
+		AlertPriority: current.AlertPriority,
+	})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	updated, err := c.Get(ctx, name)
+	if err != nil {
+		result.Err = errors.Wrap(err, "Could not verify the owner team of heartbeat "+name)
+		return result
+	}
+	if !sameTeam(updated.OwnerTeam, owner) {
+		result.Err = errors.New("Owner team of heartbeat " + name + " did not change.")
+		return result
+	}
+	result.Transferred = true
+	return result
+}
+
+// sameTeam compares teams by id when both have one, otherwise by name.
+func sameTeam(team og.OwnerTeam, other og.OwnerTeam) bool {
+	if team.Id != "" && other.Id != "" {
+		return team.Id == other.Id
+	}
+	return team.Name != "" && team.Name == other.Name
+}
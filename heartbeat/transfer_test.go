@@ -0,0 +1,73 @@
+package heartbeat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferOwnership(t *testing.T) {
+	var mux sync.Mutex
+	owners := map[string]og.OwnerTeam{"hb1": {Name: "TeamA"}, "hb2": {Name: "TeamB"}}
+	updates := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.Lock()
+		defer mux.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		name := strings.TrimPrefix(r.URL.Path, "/v2/heartbeats/")
+		owner, ok := owners[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "Heartbeat not found", "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			update := &UpdateRequest{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(update))
+			assert.Equal(t, 10, update.Interval)
+			assert.Equal(t, Minutes, update.IntervalUnit)
+			owners[name] = update.OwnerTeam
+			updates++
+			fmt.Fprintf(w, `{"data": {"name": "%s", "enabled": true}, "took": 0.1, "requestId": "rId"}`, name)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"name": "%s", "interval": 10, "intervalUnit": "minutes", "enabled": true, "ownerTeam": {"name": "%s"}}, "took": 0.1, "requestId": "rId"}`, name, owner.Name)
+	}))
+	defer ts.Close()
+
+	heartbeatClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = heartbeatClient.TransferOwnership(context.Background(), []string{"hb1"}, og.OwnerTeam{}, TransferOptions{})
+	assert.Equal(t, "Owner team cannot be empty.", err.Error())
+
+	names := []string{"hb1", "hb2", "hb3"}
+	results, err := heartbeatClient.TransferOwnership(context.Background(), names, og.OwnerTeam{Name: "TeamB"}, TransferOptions{DryRun: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, updates)
+	assert.Equal(t, "TeamA", results[0].PreviousOwner.Name)
+	assert.False(t, results[0].Transferred)
+
+	results, err = heartbeatClient.TransferOwnership(context.Background(), names, og.OwnerTeam{Name: "TeamB"}, TransferOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, updates)
+	assert.Equal(t, 3, len(results))
+	assert.True(t, results[0].Transferred)
+	assert.Nil(t, results[0].Err)
+	assert.Equal(t, "TeamB", owners["hb1"].Name)
+	assert.False(t, results[1].Transferred)
+	assert.Nil(t, results[1].Err)
+	assert.NotNil(t, results[2].Err)
+}
@@ -0,0 +1,196 @@
+package incident
+
+import (
+	"context"
+	"strings"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+)
+
+const (
+	// LinkedAlertsDetail and LinkedIncidentDetail are the details Annotate adds to the incident and its alerts. They
+	// hold comma separated ids; the ones ending with "-url" hold web links.
+	LinkedAlertsDetail      = "linked-alerts"
+	LinkedAlertsUrlDetail   = "linked-alerts-url"
+	LinkedIncidentDetail    = "linked-incident"
+	LinkedIncidentUrlDetail = "linked-incident-url"
+
+	defaultCrossLinkQuery   = "status: open"
+	crossLinkConcurrency    = 4
+	crossLinkIncidentsLimit = 100
+)
+
+// CrossLinker looks up the alerts of incidents and the incidents of alerts, and annotates both sides with details
+// referencing the other, e.g. for postmortem exports.
+type CrossLinker struct {
+	incidentClient *Client
+	alertClient    *alert.Client
+	// WebUrl, e.g. "https://myaccount.app.opsgenie.com", makes Annotate add web links next to the ids.
+	WebUrl string
+	// Query selects the incidents searched for the incidents of an alert that was not annotated. Defaults to
+	// "status: open"; only the first 100 matching incidents are searched.
+	Query string
+}
+
+// CrossLinkedIncident is an incident together with its associated alerts.
+type CrossLinkedIncident struct {
+	Incident Incident
+	Alerts   []alert.GetAlertResult
+}
+
+func NewCrossLinker(incidentClient *Client, alertClient *alert.Client) (*CrossLinker, error) {
+	if incidentClient == nil || alertClient == nil {
+		return nil, errors.New("Incident and alert clients cannot be empty.")
+	}
+	return &CrossLinker{incidentClient: incidentClient, alertClient: alertClient}, nil
+}
+
+// Alerts returns the incident with the given id together with its associated alerts.
+func (l *CrossLinker) Alerts(ctx context.Context, incidentId string) (*CrossLinkedIncident, error) {
+	incident, err := l.incidentClient.Get(ctx, &GetRequest{Id: incidentId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not get incident %s", incidentId)
+	}
+	associated, err := l.incidentClient.GetAssociatedAlertIds(ctx, &GetAssociatedAlertIdsRequest{Id: incident.Id})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not get alerts of incident %s", incident.Id)
+	}
+
+	alerts := make([]alert.GetAlertResult, len(associated.AlertIds))
+	group, _ := client.NewGroup(ctx, crossLinkConcurrency, nil)
+	for i, alertId := range associated.AlertIds {
+		i, alertId := i, alertId
+		group.Go(func(ctx context.Context) error {
+			result, err := l.alertClient.Get(ctx, &alert.GetAlertRequest{IdentifierType: alert.ALERTID, IdentifierValue: alertId})
+			if err != nil {
+				return errors.Wrapf(err, "Could not get alert %s", alertId)
+			}
+			alerts[i] = *result
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return &CrossLinkedIncident{Incident: incident.Incident, Alerts: alerts}, nil
+}
+
+// Incidents returns the incidents the alert with the given id is associated with. Alerts annotated by Annotate are
+// resolved through their LinkedIncidentDetail, others by searching the incidents matching Query, which costs a
+// request per incident.
+func (l *CrossLinker) Incidents(ctx context.Context, alertId string) ([]Incident, error) {
+	alertResult, err := l.alertClient.Get(ctx, &alert.GetAlertRequest{IdentifierType: alert.ALERTID, IdentifierValue: alertId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not get alert %s", alertId)
+	}
+	if linked := alertResult.Details[LinkedIncidentDetail]; linked != "" {
+		var incidents []Incident
+		for _, incidentId := range strings.Split(linked, ",") {
+			incident, err := l.incidentClient.Get(ctx, &GetRequest{Id: incidentId})
+			if err != nil {
+				return nil, errors.Wrapf(err, "Could not get incident %s", incidentId)
+			}
+			incidents = append(incidents, incident.Incident)
+		}
+		return incidents, nil
+	}
+
+	query := l.Query
+	if query == "" {
+		query = defaultCrossLinkQuery
+	}
+	listResult, err := l.incidentClient.List(ctx, &ListRequest{Query: query, Limit: crossLinkIncidentsLimit})
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not list incidents")
+	}
+	associated := make([]bool, len(listResult.Incidents))
+	group, _ := client.NewGroup(ctx, crossLinkConcurrency, nil)
+	for i, incident := range listResult.Incidents {
+		i, incidentId := i, incident.Id
+		group.Go(func(ctx context.Context) error {
+			result, err := l.incidentClient.GetAssociatedAlertIds(ctx, &GetAssociatedAlertIdsRequest{Id: incidentId})
+			if err != nil {
+				return errors.Wrapf(err, "Could not get alerts of incident %s", incidentId)
+			}
+			for _, id := range result.AlertIds {
+				if id == alertResult.Id {
+					associated[i] = true
+				}
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	var incidents []Incident
+	for i, incident := range listResult.Incidents {
+		if associated[i] {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+// Annotate adds LinkedAlertsDetail to the incident and LinkedIncidentDetail to each of its alerts, along with web
+// links when WebUrl is set. Alerts already linked to other incidents keep those links.
+func (l *CrossLinker) Annotate(ctx context.Context, linked *CrossLinkedIncident) error {
+	if len(linked.Alerts) == 0 {
+		return nil
+	}
+	alertIds := make([]string, len(linked.Alerts))
+	alertUrls := make([]string, len(linked.Alerts))
+	for i, alertResult := range linked.Alerts {
+		alertIds[i] = alertResult.Id
+		alertUrls[i] = l.webUrl("alert/detail/" + alertResult.Id + "/details")
+	}
+	details := map[string]string{LinkedAlertsDetail: strings.Join(alertIds, ",")}
+	if l.WebUrl != "" {
+		details[LinkedAlertsUrlDetail] = strings.Join(alertUrls, ",")
+	}
+	if _, err := l.incidentClient.AddDetails(ctx, &AddDetailsRequest{Id: linked.Incident.Id, Details: details}); err != nil {
+		return errors.Wrapf(err, "Could not annotate incident %s", linked.Incident.Id)
+	}
+
+	group, _ := client.NewGroup(ctx, crossLinkConcurrency, nil)
+	for _, alertResult := range linked.Alerts {
+		alertResult := alertResult
+		group.Go(func(ctx context.Context) error {
+			details := map[string]string{
+				LinkedIncidentDetail: appendLink(alertResult.Details[LinkedIncidentDetail], linked.Incident.Id),
+			}
+			if l.WebUrl != "" {
+				details[LinkedIncidentUrlDetail] = appendLink(alertResult.Details[LinkedIncidentUrlDetail], l.webUrl("incident/detail/"+linked.Incident.Id))
+			}
+			_, err := l.alertClient.AddDetails(ctx, &alert.AddDetailsRequest{
+				IdentifierType:  alert.ALERTID,
+				IdentifierValue: alertResult.Id,
+				Details:         details,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "Could not annotate alert %s", alertResult.Id)
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
+
+func (l *CrossLinker) webUrl(path string) string {
+	return strings.TrimSuffix(l.WebUrl, "/") + "/" + path
+}
+
+// appendLink adds the link to the comma separated links unless it is already there.
+func appendLink(links string, link string) string {
+	if links == "" {
+		return link
+	}
+	for _, existing := range strings.Split(links, ",") {
+		if existing == link {
+			return links
+		}
+	}
+	return links + "," + link
+}
@@ -0,0 +1,85 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossLinker(t *testing.T) {
+	var mux sync.Mutex
+	details := make(map[string]map[string]interface{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			body := make(map[string]interface{})
+			json.NewDecoder(r.Body).Decode(&body)
+			mux.Lock()
+			details[r.URL.Path] = body["details"].(map[string]interface{})
+			mux.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"result": "Request will be processed", "took": 0.1, "requestId": "rId"}`)
+		case r.URL.Path == "/v1/incidents/i1":
+			fmt.Fprint(w, `{"data": {"id": "i1", "message": "checkout down"}, "took": 0.1, "requestId": "rId"}`)
+		case r.URL.Path == "/v1/incidents":
+			fmt.Fprint(w, `{"data": [{"id": "i1"}, {"id": "i2"}], "took": 0.1, "requestId": "rId"}`)
+		case r.URL.Path == "/v1/incidents/i1/associated-alert-ids":
+			fmt.Fprint(w, `{"data": ["a1", "a2"], "took": 0.1, "requestId": "rId"}`)
+		case r.URL.Path == "/v1/incidents/i2/associated-alert-ids":
+			fmt.Fprint(w, `{"data": ["a3"], "took": 0.1, "requestId": "rId"}`)
+		case r.URL.Path == "/v2/alerts/a1":
+			fmt.Fprint(w, `{"data": {"id": "a1", "details": {"linked-incident": "i0"}}, "took": 0.1, "requestId": "rId"}`)
+		case strings.HasPrefix(r.URL.Path, "/v2/alerts/"):
+			fmt.Fprintf(w, `{"data": {"id": "%s"}, "took": 0.1, "requestId": "rId"}`, strings.TrimPrefix(r.URL.Path, "/v2/alerts/"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	incidentClient, err := NewClient(config)
+	assert.Nil(t, err)
+	alertClient, err := alert.NewClient(config)
+	assert.Nil(t, err)
+
+	_, err = NewCrossLinker(incidentClient, nil)
+	assert.Equal(t, "Incident and alert clients cannot be empty.", err.Error())
+	linker, err := NewCrossLinker(incidentClient, alertClient)
+	assert.Nil(t, err)
+	linker.WebUrl = "https://acme.app.opsgenie.com/"
+
+	linked, err := linker.Alerts(context.Background(), "i1")
+	assert.Nil(t, err)
+	assert.Equal(t, "checkout down", linked.Incident.Message)
+	assert.Equal(t, 2, len(linked.Alerts))
+	assert.Equal(t, "a1", linked.Alerts[0].Id)
+	assert.Equal(t, "a2", linked.Alerts[1].Id)
+
+	err = linker.Annotate(context.Background(), linked)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"linked-alerts":     "a1,a2",
+		"linked-alerts-url": "https://acme.app.opsgenie.com/alert/detail/a1/details,https://acme.app.opsgenie.com/alert/detail/a2/details",
+	}, details["/v1/incidents/i1/details"])
+	assert.Equal(t, "i0,i1", details["/v2/alerts/a1/details"]["linked-incident"])
+	assert.Equal(t, "i1", details["/v2/alerts/a2/details"]["linked-incident"])
+	assert.Equal(t, "https://acme.app.opsgenie.com/incident/detail/i1", details["/v2/alerts/a2/details"]["linked-incident-url"])
+
+	incidents, err := linker.Incidents(context.Background(), "a3")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(incidents))
+	assert.Equal(t, "i2", incidents[0].Id)
+}
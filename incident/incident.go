@@ -17,6 +17,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	return &Client{opsgenieClient}, nil
 }
 
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
+
 func (c *Client) GetRequestStatus(context context.Context, request *RequestStatusRequest) (*RequestStatusResult, error) {
 	result := &RequestStatusResult{}
 	err := c.client.Exec(context, request, result)
@@ -53,6 +59,15 @@ func (c *Client) Get(context context.Context, request *GetRequest) (*GetResult,
 	return result, nil
 }
 
+func (c *Client) GetAssociatedAlertIds(context context.Context, request *GetAssociatedAlertIdsRequest) (*GetAssociatedAlertIdsResult, error) {
+	result := &GetAssociatedAlertIdsResult{}
+	err := c.client.Exec(context, request, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *Client) List(context context.Context, request *ListRequest) (*ListResult, error) {
 	result := &ListResult{}
 	err := c.client.Exec(context, request, result)
@@ -9,7 +9,7 @@ import (
 func TestGetRequestStatus_Validate(t *testing.T) {
 	request := &RequestStatusRequest{}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.").Error())
 
 	request.Id = "6b0f1d04-7911-4369-b61f-694492034558"
 	err = request.Validate()
@@ -19,17 +19,17 @@ func TestGetRequestStatus_Validate(t *testing.T) {
 func TestCreateRequest_Validate(t *testing.T) {
 	request := &CreateRequest{}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Message and ServiceId fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("message cannot be empty.; serviceId cannot be empty.").Error())
 	request.Message = "Determine who should respond"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Message and ServiceId fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("serviceId cannot be empty.").Error())
 	request.ServiceId = "S1"
 	err = request.Validate()
 	assert.Nil(t, err)
 	statusPageEntity := &StatusPageEntity{}
 	request.StatusPageEntity = statusPageEntity
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("StatusPageEntity.Title cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("statusPageEntry.title cannot be empty.").Error())
 	statusPageEntity.Title = "Use templates to prepare messaging and communication channels to responders and stakeholders"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -40,10 +40,10 @@ func TestDeleteRequest_Validate(t *testing.T) {
 		Identifier: "Blabla",
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; Identifier should be one of id, tiny.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.").Error())
 }
 
 func TestDeleteRequest_Endpoint(t *testing.T) {
@@ -59,7 +59,7 @@ func TestGetRequest_Validate(t *testing.T) {
 		Identifier: Id,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -79,7 +79,7 @@ func TestGetRequest_Endpoint(t *testing.T) {
 func TestListRequest_Validate(t *testing.T) {
 	request := &ListRequest{}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Query field cannot be empty.").Error())
+	assert.Equal(t, err.Error(), errors.New("Query cannot be empty.").Error())
 	request.Query = "status:open"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -106,7 +106,7 @@ func TestCloseRequest_Validate(t *testing.T) {
 		Identifier: Tiny,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -128,13 +128,13 @@ func TestAddNoteRequest_Validate(t *testing.T) {
 		Identifier: "Blabla",
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Note fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; Identifier should be one of id, tiny.; note cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Note fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.; note cannot be empty.").Error())
 	request.Note = "Predefine collaboration methods including video conferences, and chat channels"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.").Error())
 	request.Identifier = ""
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -153,10 +153,10 @@ func TestAddResponderRequest_Validate(t *testing.T) {
 		Identifier: Id,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("incidentId cannot be empty.; responder cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Responders field cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("responder cannot be empty.").Error())
 	responders := []Responder{
 		{
 			Name: "cem",
@@ -184,10 +184,10 @@ func TestAddTagsRequest_Validate(t *testing.T) {
 		Identifier: Tiny,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; tags cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Tags field cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("tags cannot be empty.").Error())
 	request.Tags = []string{"Opsgenie", "Create status pages to communicate proactively to all stakeholders"}
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -210,13 +210,13 @@ func TestRemoveTagsRequest_Validate(t *testing.T) {
 		Identifier: "Blabla",
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.; Id cannot be empty.; Tags cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Tags field cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.; Tags cannot be empty.").Error())
 	request.Tags = []string{"cem", "Heimdall"}
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.").Error())
 	request.Identifier = Id
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -241,10 +241,10 @@ func TestAddDetailsRequest_Validate(t *testing.T) {
 		Identifier: Id,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; details cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Details field cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("details cannot be empty.").Error())
 	request.Details = map[string]string{
 		"Opsgenie": "Easily manage on-call schedules of multiple teams",
 	}
@@ -268,10 +268,10 @@ func TestRemoveDetailsRequest_Validate(t *testing.T) {
 		Identifier: Tiny,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; Keys cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Details field cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Keys cannot be empty.").Error())
 	request.Keys = []string{"Opsgenie", "Route alerts to the right people"}
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -293,10 +293,10 @@ func TestUpdatePriorityRequestRequest_Validate(t *testing.T) {
 		Identifier: "Blabla",
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.; Id cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.").Error())
+	assert.Equal(t, err.Error(), errors.New("Identifier should be one of id, tiny.").Error())
 }
 
 func TestUpdatePriorityRequest_Endpoint(t *testing.T) {
@@ -312,10 +312,10 @@ func TestUpdateMessageRequest_Validate(t *testing.T) {
 		Identifier: Id,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Message fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; message cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Message fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("message cannot be empty.").Error())
 	request.Message = "Plan and prepare for incidents"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -337,10 +337,10 @@ func TestUpdateDescriptionRequest_Validate(t *testing.T) {
 		Identifier: Tiny,
 	}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Description fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.; description cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID and Description fields cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("description cannot be empty.").Error())
 	request.Description = "Never miss a critical alert and always notify the right people"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -360,7 +360,7 @@ func TestUpdateDescriptionRequest_Endpoint(t *testing.T) {
 func TestListLogsRequest_Validate(t *testing.T) {
 	request := &ListLogsRequest{}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -394,7 +394,7 @@ func TestListLogsRequest_Endpoint(t *testing.T) {
 func TestListNotesRequest_Validate(t *testing.T) {
 	request := &ListNotesRequest{}
 	err := request.Validate()
-	assert.Equal(t, err.Error(), errors.New("Incident ID cannot be blank.").Error())
+	assert.Equal(t, err.Error(), errors.New("Id cannot be empty.").Error())
 	request.Id = "adea9e79-5527-4e49-b345-e55ae180ae59"
 	err = request.Validate()
 	assert.Nil(t, err)
@@ -439,15 +439,14 @@ func TestResponders_Validate(t *testing.T) {
 	var Responders = []Responder{
 		{Type: ""},
 	}
-	err := validateResponders(Responders)
-	assert.Equal(t, err.Error(), errors.New("Responder type cannot be empty.").Error())
+	err := (&AddResponderRequest{Id: "adea9e79-5527-4e49-b345-e55ae180ae59", Responders: Responders}).Validate()
+	assert.Equal(t, err.Error(), errors.New("responder[0].type cannot be empty.").Error())
 
 	Responders = []Responder{
 		{Type: "Cem"},
 	}
-	err = validateResponders(Responders)
-	assert.Equal(t, err.Error(), errors.New("Responder type should be "+
-		"one of these: 'User', 'Team'.").Error())
+	err = (&AddResponderRequest{Id: "adea9e79-5527-4e49-b345-e55ae180ae59", Responders: Responders}).Validate()
+	assert.Equal(t, err.Error(), errors.New("responder[0].type should be one of user, team.").Error())
 
 	Responders = []Responder{
 		{Type: User},
@@ -482,3 +481,14 @@ func TestResponders_Validate(t *testing.T) {
 	err = validateResponders(Responders)
 	assert.Nil(t, err)
 }
+
+func TestRequestStatusResult(t *testing.T) {
+	result := &RequestStatusResult{IsSuccess: true, Status: "Created incident", IncidentId: "IN1"}
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, "IN1", result.EntityId())
+	assert.Empty(t, result.FailureReason())
+
+	result = &RequestStatusResult{IsSuccess: false, Status: "Service does not exist"}
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, "Service does not exist", result.FailureReason())
+}
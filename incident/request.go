@@ -2,7 +2,6 @@ package incident
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
@@ -11,61 +10,49 @@ import (
 
 type RequestStatusRequest struct {
 	client.BaseRequest
-	Id string
+	Id string `validate:"required"`
 }
 
 func (r *RequestStatusRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *RequestStatusRequest) ResourcePath() string {
-	return "/v1/incidents/requests/" + r.Id
+	return "/v1/incidents/requests/" + client.PathSegment(r.Id)
 }
 
 func (r *RequestStatusRequest) Method() string {
 	return http.MethodGet
 }
 
+func (r *RequestStatusRequest) AsyncRequestId() string {
+	return r.Id
+}
+
 type CreateRequest struct {
 	client.BaseRequest
-	Message            string            `json:"message"`
+	Message            string            `json:"message" validate:"required"`
 	Description        string            `json:"description,omitempty"`
-	Responders         []Responder       `json:"responders,omitempty"`
-	Tags               []string          `json:"tags,omitempty"`
+	Responders         []Responder       `json:"responders,omitempty" validate:"nested"`
+	Tags               []string          `json:"tags,omitempty" validate:"max=20"`
 	Details            map[string]string `json:"details,omitempty"`
-	Priority           Priority          `json:"priority,omitempty"`
+	Priority           Priority          `json:"priority,omitempty" validate:"oneof=P1 P2 P3 P4 P5"`
 	Note               string            `json:"note,omitempty"`
-	ServiceId          string            `json:"serviceId"`
-	StatusPageEntity   *StatusPageEntity `json:"statusPageEntry,omitempty"`
+	ServiceId          string            `json:"serviceId" validate:"required"`
+	StatusPageEntity   *StatusPageEntity `json:"statusPageEntry,omitempty" validate:"nested"`
 	NotifyStakeholders *bool             `json:"notifyStakeholders,omitempty"`
 }
 
 type StatusPageEntity struct {
-	Title       string `json:"title,omitempty"`
+	Title       string `json:"title,omitempty" validate:"required"`
 	Description string `json:"description,omitempty"`
 }
 
 func (r *CreateRequest) Validate() error {
-	if r.Message == "" || r.ServiceId == "" {
-		return errors.New("Message and ServiceId fields cannot be blank.")
-	}
-	if r.StatusPageEntity != nil {
-		if r.StatusPageEntity.Title == "" {
-			return errors.New("StatusPageEntity.Title cannot be blank.")
-		}
-	}
-	err := ValidatePriority(r.Priority)
-	if err != nil {
+	if err := client.ValidateStruct(r); err != nil {
 		return err
 	}
-	err = validateResponders(r.Responders)
-	if err != nil {
-		return err
-	}
-	return nil
+	return validateResponders(r.Responders)
 }
 
 func (r *CreateRequest) ResourcePath() string {
@@ -78,22 +65,16 @@ func (r *CreateRequest) Method() string {
 
 type DeleteRequest struct {
 	client.BaseRequest
-	Id         string
-	Identifier IdentifierType
+	Id         string         `validate:"required"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
 }
 
 func (r *DeleteRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *DeleteRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id
+	return "/v1/incidents/" + client.PathSegment(r.Id)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -115,22 +96,16 @@ func (r *DeleteRequest) RequestParams() map[string]string {
 
 type GetRequest struct {
 	client.BaseRequest
-	Id         string
-	Identifier IdentifierType
+	Id         string         `validate:"required"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
 }
 
 func (r *GetRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id
+	return "/v1/incidents/" + client.PathSegment(r.Id)
 }
 
 func (r *GetRequest) Method() string {
@@ -149,20 +124,54 @@ func (r *GetRequest) RequestParams() map[string]string {
 	return params
 }
 
+type GetAssociatedAlertIdsRequest struct {
+	client.BaseRequest
+	Id         string         `validate:"required"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+}
+
+func (r *GetAssociatedAlertIdsRequest) Validate() error {
+	return client.ValidateStruct(r)
+}
+
+func (r *GetAssociatedAlertIdsRequest) ResourcePath() string {
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/associated-alert-ids"
+}
+
+func (r *GetAssociatedAlertIdsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (r *GetAssociatedAlertIdsRequest) RequestParams() map[string]string {
+
+	params := make(map[string]string)
+
+	if r.Identifier == Tiny {
+		params["identifierType"] = "tiny"
+	} else {
+		params["identifierType"] = "id"
+	}
+	return params
+}
+
 type ListRequest struct {
 	client.BaseRequest
 	Limit  int
 	Sort   SortField
 	Offset int
 	Order  Order
-	Query  string
+	Query  string `validate:"required"`
 }
 
 func (r *ListRequest) Validate() error {
-	if r.Query == "" {
-		return errors.New("Query field cannot be empty.")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Sort: string(r.Sort), Order: string(r.Order), Query: r.Query}
 }
 
 func (r *ListRequest) ResourcePath() string {
@@ -176,52 +185,34 @@ func (r *ListRequest) Method() string {
 func (r *ListRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
+	r.listParams().AddTo(params)
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-	if r.Sort != "" {
-		params["sort"] = string(r.Sort)
-	}
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
-	if r.Query != "" {
-		params["query"] = r.Query
-
-	}
-	if r.Order != "" {
-		params["order"] = string(r.Order)
-
-	}
 	return params
 }
 
 type CloseRequest struct {
 	client.BaseRequest
-	Id         string
-	Identifier IdentifierType
-	Note       string `json:"note,omitempty"`
+	Id         string         `validate:"required"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Note       string         `json:"note,omitempty"`
 }
 
 func (r *CloseRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *CloseRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/close"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/close"
 }
 
 func (r *CloseRequest) Method() string {
 	return http.MethodPost
 }
 
+func (r *CloseRequest) IsRemoval() bool {
+	return true
+}
+
 func (r *CloseRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
@@ -237,23 +228,17 @@ func (r *CloseRequest) RequestParams() map[string]string {
 
 type AddNoteRequest struct {
 	client.BaseRequest
-	Id         string
-	Identifier IdentifierType
-	Note       string `json:"note"`
+	Id         string         `validate:"required"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Note       string         `json:"note" validate:"required"`
 }
 
 func (r *AddNoteRequest) Validate() error {
-	if r.Id == "" || r.Note == "" {
-		return errors.New("Incident ID and Note fields cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddNoteRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/notes"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/notes"
 
 }
 
@@ -276,31 +261,21 @@ func (r *AddNoteRequest) RequestParams() map[string]string {
 
 type AddResponderRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string      `json:"incidentId"`
-	Note       string      `json:"note"`
-	Responders []Responder `json:"responder"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `json:"incidentId" validate:"required"`
+	Note       string         `json:"note"`
+	Responders []Responder    `json:"responder" validate:"required,nested"`
 }
 
 func (r *AddResponderRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if len(r.Responders) == 0 {
-		return errors.New("Responders field cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	err := validateResponders(r.Responders)
-	if err != nil {
+	if err := client.ValidateStruct(r); err != nil {
 		return err
 	}
-	return nil
+	return validateResponders(r.Responders)
 }
 
 func (r *AddResponderRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/responders"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/responders"
 
 }
 
@@ -323,27 +298,18 @@ func (r *AddResponderRequest) RequestParams() map[string]string {
 
 type AddTagsRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
-	Note       string   `json:"note"`
-	Tags       []string `json:"tags"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
+	Note       string         `json:"note"`
+	Tags       []string       `json:"tags" validate:"required"`
 }
 
 func (r *AddTagsRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if len(r.Tags) == 0 {
-		return errors.New("Tags field cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddTagsRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/tags"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/tags"
 }
 
 func (r *AddTagsRequest) Method() string {
@@ -365,27 +331,18 @@ func (r *AddTagsRequest) RequestParams() map[string]string {
 
 type RemoveTagsRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
 	Note       string
-	Tags       []string
+	Tags       []string `validate:"required"`
 }
 
 func (r *RemoveTagsRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if len(r.Tags) == 0 {
-		return errors.New("Tags field cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *RemoveTagsRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/tags"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/tags"
 }
 
 func (r *RemoveTagsRequest) Method() string {
@@ -414,27 +371,18 @@ func (r *RemoveTagsRequest) RequestParams() map[string]string {
 
 type AddDetailsRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
+	Identifier IdentifierType    `validate:"oneof=id tiny"`
+	Id         string            `validate:"required"`
 	Note       string            `json:"note,omitempty"`
-	Details    map[string]string `json:"details"`
+	Details    map[string]string `json:"details" validate:"required"`
 }
 
 func (r *AddDetailsRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if len(r.Details) == 0 {
-		return errors.New("Details field cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *AddDetailsRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/details"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/details"
 }
 
 func (r *AddDetailsRequest) Method() string {
@@ -456,27 +404,18 @@ func (r *AddDetailsRequest) RequestParams() map[string]string {
 
 type RemoveDetailsRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
 	Note       string
-	Keys       []string
+	Keys       []string `validate:"required"`
 }
 
 func (r *RemoveDetailsRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if len(r.Keys) == 0 {
-		return errors.New("Details field cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *RemoveDetailsRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/details"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/details"
 }
 
 func (r *RemoveDetailsRequest) Method() string {
@@ -505,27 +444,17 @@ func (r *RemoveDetailsRequest) RequestParams() map[string]string {
 
 type UpdatePriorityRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
-	Priority   Priority `json:"priority"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
+	Priority   Priority       `json:"priority" validate:"oneof=P1 P2 P3 P4 P5"`
 }
 
 func (r *UpdatePriorityRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	err := ValidatePriority(r.Priority)
-	if err != nil {
-		return err
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdatePriorityRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/priority"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/priority"
 
 }
 
@@ -548,23 +477,17 @@ func (r *UpdatePriorityRequest) RequestParams() map[string]string {
 
 type UpdateMessageRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
-	Message    string `json:"message"`
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
+	Message    string         `json:"message" validate:"required"`
 }
 
 func (r *UpdateMessageRequest) Validate() error {
-	if r.Id == "" || r.Message == "" {
-		return errors.New("Incident ID and Message fields cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdateMessageRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/message"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/message"
 
 }
 
@@ -587,23 +510,17 @@ func (r *UpdateMessageRequest) RequestParams() map[string]string {
 
 type UpdateDescriptionRequest struct {
 	client.BaseRequest
-	Identifier  IdentifierType
-	Id          string
-	Description string `json:"description"`
+	Identifier  IdentifierType `validate:"oneof=id tiny"`
+	Id          string         `validate:"required"`
+	Description string         `json:"description" validate:"required"`
 }
 
 func (r *UpdateDescriptionRequest) Validate() error {
-	if r.Id == "" || r.Description == "" {
-		return errors.New("Incident ID and Description fields cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
-	}
-	return nil
+	return client.ValidateStruct(r)
 }
 
 func (r *UpdateDescriptionRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/description"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/description"
 }
 
 func (r *UpdateDescriptionRequest) Method() string {
@@ -625,8 +542,8 @@ func (r *UpdateDescriptionRequest) RequestParams() map[string]string {
 
 type ListLogsRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
 	Limit      int
 	Offset     int
 	Order      Order
@@ -634,17 +551,18 @@ type ListLogsRequest struct {
 }
 
 func (r *ListLogsRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListLogsRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Order: string(r.Order)}
 }
 
 func (r *ListLogsRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/logs"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/logs"
 }
 
 func (r *ListLogsRequest) Method() string {
@@ -661,27 +579,19 @@ func (r *ListLogsRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
+	r.listParams().AddTo(params)
 	if r.Direction != "" {
 		params["direction"] = r.Direction
 
 	}
-	if r.Order != "" {
-		params["order"] = string(r.Order)
-	}
 
 	return params
 }
 
 type ListNotesRequest struct {
 	client.BaseRequest
-	Identifier IdentifierType
-	Id         string
+	Identifier IdentifierType `validate:"oneof=id tiny"`
+	Id         string         `validate:"required"`
 	Limit      int
 	Offset     int
 	Order      Order
@@ -689,17 +599,18 @@ type ListNotesRequest struct {
 }
 
 func (r *ListNotesRequest) Validate() error {
-	if r.Id == "" {
-		return errors.New("Incident ID cannot be blank.")
-	}
-	if r.Identifier != "" && r.Identifier != Id && r.Identifier != Tiny {
-		return errors.New("Identifier type should be one of these: 'Id', 'Tiny' or empty.")
+	if err := client.ValidateStruct(r); err != nil {
+		return err
 	}
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListNotesRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Order: string(r.Order)}
 }
 
 func (r *ListNotesRequest) ResourcePath() string {
-	return "/v1/incidents/" + r.Id + "/notes"
+	return "/v1/incidents/" + client.PathSegment(r.Id) + "/notes"
 
 }
 
@@ -717,19 +628,11 @@ func (r *ListNotesRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
+	r.listParams().AddTo(params)
 	if r.Direction != "" {
 		params["direction"] = r.Direction
 
 	}
-	if r.Order != "" {
-		params["order"] = string(r.Order)
-	}
 
 	return params
 }
@@ -765,19 +668,14 @@ const (
 )
 
 type Responder struct {
-	Type ResponderType `json:"type, omitempty"`
+	Type ResponderType `json:"type, omitempty" validate:"required,oneof=user team"`
 	Name string        `json:"name,omitempty"`
 	Id   string        `json:"id,omitempty"`
 }
 
+// validateResponders checks the rules of responders their tags cannot express.
 func validateResponders(responders []Responder) error {
 	for _, responder := range responders {
-		if responder.Type == "" {
-			return errors.New("Responder type cannot be empty.")
-		}
-		if !(responder.Type == User || responder.Type == Team) {
-			return errors.New("Responder type should be one of these: 'User', 'Team'.")
-		}
 		if responder.Name == "" && responder.Id == "" {
 			return errors.New("For responder either name or id must be provided.")
 		}
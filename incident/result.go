@@ -31,6 +31,24 @@ type RequestStatusResult struct {
 	IncidentId    string `json:"incidentId"`
 }
 
+// Succeeded reports whether OpsGenie processed the request successfully.
+func (r *RequestStatusResult) Succeeded() bool {
+	return r.IsSuccess
+}
+
+// EntityId returns the id of the incident the request created or acted on.
+func (r *RequestStatusResult) EntityId() string {
+	return r.IncidentId
+}
+
+// FailureReason returns the reason reported by OpsGenie when the request could not be processed.
+func (r *RequestStatusResult) FailureReason() string {
+	if r.IsSuccess {
+		return ""
+	}
+	return r.Status
+}
+
 type AsyncResult struct {
 	client.ResultMetadata
 	Result string `json:"result"`
@@ -41,6 +59,11 @@ type GetResult struct {
 	Incident
 }
 
+type GetAssociatedAlertIdsResult struct {
+	client.ResultMetadata
+	AlertIds []string `json:"data"`
+}
+
 type ListResult struct {
 	client.ResultMetadata
 	Incidents []Incident `json:"data"`
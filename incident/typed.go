@@ -0,0 +1,19 @@
+//go:build go1.21
+// +build go1.21
+
+package incident
+
+import (
+	"context"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+)
+
+// ExecTyped executes a request of the incident API with the client, e.g. one of an endpoint the client has no method
+// for yet, and returns its result as R. See client.ExecTyped.
+func ExecTyped[R any, PR interface {
+	*R
+	client.ApiResult
+}](ctx context.Context, c *Client, request client.ApiRequest) (*R, error) {
+	return client.ExecTyped[R, PR](ctx, c.client, request)
+}
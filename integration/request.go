@@ -21,7 +21,7 @@ func (r *GetRequest) Validate() error {
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id
+	return "/v2/integrations/" + client.PathSegment(r.Id)
 }
 
 func (r *GetRequest) Method() string {
@@ -138,7 +138,7 @@ func (r OtherFields) Validate() error {
 }
 
 func (r OtherFields) ResourcePath() string {
-	return "/v2/integrations/" + r["id"].(string)
+	return "/v2/integrations/" + client.PathSegment(r["id"].(string))
 }
 
 func (r OtherFields) Method() string {
@@ -169,7 +169,7 @@ func (r *DeleteIntegrationRequest) Validate() error {
 }
 
 func (r *DeleteIntegrationRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id
+	return "/v2/integrations/" + client.PathSegment(r.Id)
 }
 
 func (r *DeleteIntegrationRequest) Method() string {
@@ -189,7 +189,7 @@ func (r *EnableIntegrationRequest) Validate() error {
 }
 
 func (r *EnableIntegrationRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id + "/enable"
+	return "/v2/integrations/" + client.PathSegment(r.Id) + "/enable"
 }
 
 func (r *EnableIntegrationRequest) Method() string {
@@ -209,7 +209,7 @@ func (r *DisableIntegrationRequest) Validate() error {
 }
 
 func (r *DisableIntegrationRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id + "/disable"
+	return "/v2/integrations/" + client.PathSegment(r.Id) + "/disable"
 }
 
 func (r *DisableIntegrationRequest) Method() string {
@@ -249,7 +249,7 @@ func (r *GetIntegrationActionsRequest) Validate() error {
 }
 
 func (r *GetIntegrationActionsRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id + "/actions"
+	return "/v2/integrations/" + client.PathSegment(r.Id) + "/actions"
 }
 
 func (r *GetIntegrationActionsRequest) Method() string {
@@ -306,7 +306,7 @@ func (r *CreateIntegrationActionsRequest) Validate() error {
 }
 
 func (r *CreateIntegrationActionsRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id + "/actions"
+	return "/v2/integrations/" + client.PathSegment(r.Id) + "/actions"
 }
 
 func (r *CreateIntegrationActionsRequest) Method() string {
@@ -389,7 +389,7 @@ func validateActions(actions []IntegrationAction) error {
 }
 
 func (r *UpdateAllIntegrationActionsRequest) ResourcePath() string {
-	return "/v2/integrations/" + r.Id + "/actions"
+	return "/v2/integrations/" + client.PathSegment(r.Id) + "/actions"
 }
 
 func (r *UpdateAllIntegrationActionsRequest) Method() string {
@@ -0,0 +1,278 @@
+package integrity
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/escalation"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/schedule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/user"
+	"github.com/pkg/errors"
+)
+
+type SourceType string
+type ReferenceType string
+
+const (
+	EscalationSource  SourceType = "escalation"
+	ScheduleSource    SourceType = "schedule"
+	RoutingRuleSource SourceType = "routing-rule"
+
+	TeamReference       ReferenceType = "team"
+	UserReference       ReferenceType = "user"
+	ScheduleReference   ReferenceType = "schedule"
+	EscalationReference ReferenceType = "escalation"
+)
+
+// Snapshot holds configuration fetched from OpsGenie. Users is optional, user references are
+// only checked when it is not nil.
+type Snapshot struct {
+	Escalations  []escalation.Escalation
+	Schedules    []schedule.Schedule
+	Teams        []team.ListedTeams
+	RoutingRules map[string][]team.RoutingRuleMeta
+	Users        []user.User
+}
+
+// Finding describes a reference from a source entity to an entity that does not exist in the snapshot.
+type Finding struct {
+	SourceType    SourceType
+	SourceId      string
+	SourceName    string
+	Owner         string
+	ReferenceType ReferenceType
+	ReferenceId   string
+	ReferenceName string
+	Message       string
+}
+
+type index struct {
+	ids   map[string]bool
+	names map[string]bool
+}
+
+func newIndex() *index {
+	return &index{ids: make(map[string]bool), names: make(map[string]bool)}
+}
+
+func (i *index) add(id, name string) {
+	if id != "" {
+		i.ids[id] = true
+	}
+	if name != "" {
+		i.names[name] = true
+	}
+}
+
+func (i *index) contains(id, name string) bool {
+	if id != "" {
+		return i.ids[id]
+	}
+	return i.names[name]
+}
+
+// Check walks the snapshot and returns a finding for each dangling reference.
+func Check(snapshot *Snapshot) []Finding {
+	findings := make([]Finding, 0)
+	if snapshot == nil {
+		return findings
+	}
+
+	teams := newIndex()
+	for _, t := range snapshot.Teams {
+		teams.add(t.Id, t.Name)
+	}
+	schedules := newIndex()
+	for _, s := range snapshot.Schedules {
+		schedules.add(s.Id, s.Name)
+	}
+	escalations := newIndex()
+	for _, e := range snapshot.Escalations {
+		escalations.add(e.Id, e.Name)
+	}
+	var users *index
+	if snapshot.Users != nil {
+		users = newIndex()
+		for _, u := range snapshot.Users {
+			users.add(u.Id, u.Username)
+		}
+	}
+
+	for _, e := range snapshot.Escalations {
+		for _, rule := range e.Rules {
+			if finding := checkParticipant(rule.Recipient, teams, schedules, escalations, users); finding != nil {
+				finding.SourceType = EscalationSource
+				finding.SourceId = e.Id
+				finding.SourceName = e.Name
+				findings = append(findings, *finding)
+			}
+		}
+	}
+
+	for _, s := range snapshot.Schedules {
+		for _, rotation := range s.Rotations {
+			for _, participant := range rotation.Participants {
+				if finding := checkParticipant(participant, teams, schedules, escalations, users); finding != nil {
+					finding.SourceType = ScheduleSource
+					finding.SourceId = s.Id
+					finding.SourceName = s.Name
+					findings = append(findings, *finding)
+				}
+			}
+		}
+	}
+
+	// Teams are checked in the order of their ids, so findings are reported in the same order on every check.
+	teamIds := make([]string, 0, len(snapshot.RoutingRules))
+	for teamId := range snapshot.RoutingRules {
+		teamIds = append(teamIds, teamId)
+	}
+	sort.Strings(teamIds)
+	for _, teamId := range teamIds {
+		for _, rule := range snapshot.RoutingRules[teamId] {
+			var finding *Finding
+			switch rule.Notify.Type {
+			case team.EscalationNotifyType:
+				if !escalations.contains(rule.Notify.Id, rule.Notify.Name) {
+					finding = newFinding(EscalationReference, rule.Notify.Id, rule.Notify.Name)
+				}
+			case team.ScheduleNotifyType:
+				if !schedules.contains(rule.Notify.Id, rule.Notify.Name) {
+					finding = newFinding(ScheduleReference, rule.Notify.Id, rule.Notify.Name)
+				}
+			}
+			if finding != nil {
+				finding.SourceType = RoutingRuleSource
+				finding.SourceId = rule.Id
+				finding.SourceName = rule.Name
+				finding.Owner = teamId
+				findings = append(findings, *finding)
+			}
+		}
+	}
+
+	return findings
+}
+
+func checkParticipant(participant og.Participant, teams, schedules, escalations, users *index) *Finding {
+	switch participant.Type {
+	case og.Team:
+		if !teams.contains(participant.Id, participant.Name) {
+			return newFinding(TeamReference, participant.Id, participant.Name)
+		}
+	case og.Schedule:
+		if !schedules.contains(participant.Id, participant.Name) {
+			return newFinding(ScheduleReference, participant.Id, participant.Name)
+		}
+	case og.Escalation:
+		if !escalations.contains(participant.Id, participant.Name) {
+			return newFinding(EscalationReference, participant.Id, participant.Name)
+		}
+	case og.User:
+		if users != nil && !users.contains(participant.Id, participant.Username) {
+			return newFinding(UserReference, participant.Id, participant.Username)
+		}
+	}
+	return nil
+}
+
+func newFinding(referenceType ReferenceType, id, name string) *Finding {
+	reference := id
+	if reference == "" {
+		reference = name
+	}
+	return &Finding{
+		ReferenceType: referenceType,
+		ReferenceId:   id,
+		ReferenceName: name,
+		Message:       string(referenceType) + " " + reference + " does not exist",
+	}
+}
+
+type Clients struct {
+	Escalation *escalation.Client
+	Schedule   *schedule.Client
+	Team       *team.Client
+	User       *user.Client
+}
+
+// Collect fetches the configuration needed by Check. The user client is optional.
+func Collect(ctx context.Context, clients *Clients) (*Snapshot, error) {
+	if clients == nil || clients.Escalation == nil || clients.Schedule == nil || clients.Team == nil {
+		return nil, errors.New("Escalation, schedule and team clients cannot be empty.")
+	}
+	snapshot := &Snapshot{RoutingRules: make(map[string][]team.RoutingRuleMeta)}
+
+	escalations, err := clients.Escalation.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Escalations = escalations.Escalations
+
+	expand := true
+	schedules, err := clients.Schedule.List(ctx, &schedule.ListRequest{Expand: &expand})
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Schedules = schedules.Schedule
+
+	teams, err := clients.Team.List(ctx, &team.ListTeamRequest{})
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Teams = teams.Teams
+
+	for _, t := range snapshot.Teams {
+		rules, err := clients.Team.ListRoutingRules(ctx, &team.ListRoutingRulesRequest{
+			TeamIdentifierType:  team.Id,
+			TeamIdentifierValue: t.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		snapshot.RoutingRules[t.Id] = rules.RoutingRules
+	}
+
+	if clients.User != nil {
+		users, err := listUsers(ctx, clients.User)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Users = users
+	}
+
+	return snapshot, nil
+}
+
+// listUsers lists the users of all pages, following the next links of the responses.
+func listUsers(ctx context.Context, client *user.Client) ([]user.User, error) {
+	users := make([]user.User, 0)
+	request := &user.ListRequest{}
+	for {
+		result, err := client.List(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, result.Users...)
+		if result.Paging.Next == "" || len(result.Users) == 0 {
+			return users, nil
+		}
+		next, err := url.Parse(result.Paging.Next)
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not parse the next page of users")
+		}
+		offset, err := strconv.Atoi(next.Query().Get("offset"))
+		if err != nil || offset <= request.Offset {
+			offset = request.Offset + len(result.Users)
+		}
+		request = &user.ListRequest{}
+		request.Offset = offset
+		if limit, err := strconv.Atoi(next.Query().Get("limit")); err == nil {
+			request.Limit = limit
+		}
+	}
+}
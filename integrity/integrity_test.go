@@ -0,0 +1,123 @@
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/escalation"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/schedule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	snapshot := &Snapshot{
+		Teams: []team.ListedTeams{{TeamMeta: team.TeamMeta{Id: "t1", Name: "ops"}}},
+		Schedules: []schedule.Schedule{
+			{
+				Id:   "s1",
+				Name: "ops_schedule",
+				Rotations: []og.Rotation{
+					{Participants: []og.Participant{{Type: og.User, Username: "gone@example.com"}}},
+				},
+			},
+		},
+		Escalations: []escalation.Escalation{
+			{
+				Id:   "e1",
+				Name: "ops_escalation",
+				Rules: []escalation.Rule{
+					{Recipient: og.Participant{Type: og.Schedule, Name: "ops_schedule"}},
+					{Recipient: og.Participant{Type: og.Schedule, Id: "deleted"}},
+					{Recipient: og.Participant{Type: og.Team, Name: "ops"}},
+				},
+			},
+		},
+		RoutingRules: map[string][]team.RoutingRuleMeta{
+			"t1": {
+				{Id: "r1", Name: "default", Notify: team.Notify{Type: team.EscalationNotifyType, Name: "ops_escalation"}},
+				{Id: "r2", Name: "night", Notify: team.Notify{Type: team.ScheduleNotifyType, Name: "old_schedule"}},
+				{Id: "r3", Name: "muted", Notify: team.Notify{Type: team.None}},
+			},
+		},
+	}
+
+	findings := Check(snapshot)
+	assert.Equal(t, 2, len(findings))
+	assert.Equal(t, Finding{
+		SourceType:    EscalationSource,
+		SourceId:      "e1",
+		SourceName:    "ops_escalation",
+		ReferenceType: ScheduleReference,
+		ReferenceId:   "deleted",
+		Message:       "schedule deleted does not exist",
+	}, findings[0])
+	assert.Equal(t, RoutingRuleSource, findings[1].SourceType)
+	assert.Equal(t, "t1", findings[1].Owner)
+	assert.Equal(t, "old_schedule", findings[1].ReferenceName)
+
+	snapshot.Users = []user.User{{Id: "u1", Username: "user@example.com"}}
+	findings = Check(snapshot)
+	assert.Equal(t, 3, len(findings))
+	assert.Equal(t, ScheduleSource, findings[1].SourceType)
+	assert.Equal(t, UserReference, findings[1].ReferenceType)
+	assert.Equal(t, "gone@example.com", findings[1].ReferenceName)
+}
+
+func TestCheckEmptySnapshot(t *testing.T) {
+	assert.Empty(t, Check(nil))
+	assert.Empty(t, Check(&Snapshot{}))
+}
+
+func TestCheckOrdersRoutingRulesByTeam(t *testing.T) {
+	snapshot := &Snapshot{RoutingRules: map[string][]team.RoutingRuleMeta{}}
+	for _, teamId := range []string{"t3", "t1", "t2"} {
+		snapshot.RoutingRules[teamId] = []team.RoutingRuleMeta{
+			{Id: "r", Name: "default", Notify: team.Notify{Type: team.ScheduleNotifyType, Name: "missing"}},
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		findings := Check(snapshot)
+		assert.Equal(t, 3, len(findings))
+		assert.Equal(t, "t1", findings[0].Owner)
+		assert.Equal(t, "t2", findings[1].Owner)
+		assert.Equal(t, "t3", findings[2].Owner)
+	}
+}
+
+func TestListUsersPages(t *testing.T) {
+	var offsets []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if offset == "" {
+			fmt.Fprint(w, `{"data":[{"id":"u1","username":"first@example.com"}],"paging":{"next":"https://api.opsgenie.com/v2/users?limit=1&offset=1"},"totalCount":2,"took":0.1,"requestId":"rid"}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":"u2","username":"second@example.com"}],"paging":{},"totalCount":2,"took":0.1,"requestId":"rid"}`)
+	}))
+	defer ts.Close()
+
+	userClient, err := user.NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	users, err := listUsers(context.Background(), userClient)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"", "1"}, offsets)
+	assert.Equal(t, 2, len(users))
+	assert.Equal(t, "first@example.com", users[0].Username)
+	assert.Equal(t, "second@example.com", users[1].Username)
+}
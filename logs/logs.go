@@ -23,6 +23,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	return &Client{opsgenieClient}, nil
 }
 
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
+
 func (c *Client) ListLogFiles(ctx context.Context, req *ListLogFilesRequest) (*ListLogFilesResult, error) {
 	listLogFilesResponse := &ListLogFilesResult{}
 
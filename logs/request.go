@@ -2,7 +2,6 @@ package logs
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -19,11 +18,15 @@ func (r *ListLogFilesRequest) Validate() error {
 		return errors.New("marker cannot be empty")
 	}
 
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListLogFilesRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit}
 }
 
 func (r *ListLogFilesRequest) ResourcePath() string {
-	return "/v2/logs/list/" + r.Marker
+	return "/v2/logs/list/" + client.PathSegment(r.Marker)
 }
 
 func (r *ListLogFilesRequest) Method() string {
@@ -34,9 +37,7 @@ func (r *ListLogFilesRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
 
-	if r.Limit >= 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
+	r.listParams().AddTo(params)
 
 	return params
 }
@@ -55,7 +56,7 @@ func (r *GenerateLogFileDownloadLinkRequest) Validate() error {
 }
 
 func (r *GenerateLogFileDownloadLinkRequest) ResourcePath() string {
-	return "/v2/logs/download/" + r.FileName
+	return "/v2/logs/download/" + client.PathSegment(r.FileName)
 }
 
 func (r *GenerateLogFileDownloadLinkRequest) Method() string {
@@ -51,7 +51,7 @@ func (r *GetRequest) Validate() error {
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v1/maintenance/" + r.Id
+	return "/v1/maintenance/" + client.PathSegment(r.Id)
 }
 
 func (r *GetRequest) Method() string {
@@ -85,7 +85,7 @@ func (r *UpdateRequest) Validate() error {
 }
 
 func (r *UpdateRequest) ResourcePath() string {
-	return "/v1/maintenance/" + r.Id
+	return "/v1/maintenance/" + client.PathSegment(r.Id)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -109,7 +109,7 @@ func (r *ChangeEndDateRequest) Validate() error {
 }
 
 func (r *ChangeEndDateRequest) ResourcePath() string {
-	return "/v1/maintenance/" + r.Id + "/change-end-date"
+	return "/v1/maintenance/" + client.PathSegment(r.Id) + "/change-end-date"
 }
 
 func (r *ChangeEndDateRequest) Method() string {
@@ -129,7 +129,7 @@ func (r *DeleteRequest) Validate() error {
 }
 
 func (r *DeleteRequest) ResourcePath() string {
-	return "/v1/maintenance/" + r.Id
+	return "/v1/maintenance/" + client.PathSegment(r.Id)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -170,7 +170,7 @@ func (r *CancelRequest) Validate() error {
 }
 
 func (r *CancelRequest) ResourcePath() string {
-	return "/v1/maintenance/" + r.Id + "/cancel"
+	return "/v1/maintenance/" + client.PathSegment(r.Id) + "/cancel"
 }
 
 func (r *CancelRequest) Method() string {
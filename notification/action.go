@@ -0,0 +1,85 @@
+package notification
+
+import (
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/pkg/errors"
+)
+
+// Notify methods of the contacts of rule steps.
+const (
+	SmsMethod    = og.Sms
+	EmailMethod  = og.Email
+	VoiceMethod  = og.Voice
+	MobileMethod = og.Mobile
+)
+
+var actionTypes = map[ActionType]bool{
+	CreateAlert:         true,
+	AcknowledgedAlert:   true,
+	ClosedAlert:         true,
+	AssignedAlert:       true,
+	AddNote:             true,
+	ScheduleStart:       true,
+	ScheduleEnd:         true,
+	IncomingCallRouting: true,
+}
+
+var notificationTimes = map[NotificationTimeType]bool{
+	JustBefore:        true,
+	FifteenMinutesAgo: true,
+	OneHourAgo:        true,
+	OneDayAgo:         true,
+}
+
+var notifyMethods = map[og.MethodType]bool{
+	SmsMethod:    true,
+	EmailMethod:  true,
+	VoiceMethod:  true,
+	MobileMethod: true,
+}
+
+// IsScheduleAction reports whether rules of the action type notify about on-call periods of schedules.
+func (t ActionType) IsScheduleAction() bool {
+	return t == ScheduleStart || t == ScheduleEnd
+}
+
+// IsDelayedAction reports whether the steps of rules of the action type can be delayed with SendAfter.
+func (t ActionType) IsDelayedAction() bool {
+	return t == CreateAlert || t == AssignedAlert
+}
+
+func validateActionType(actionType ActionType) error {
+	if !actionTypes[actionType] {
+		return errors.Errorf("Action type %s is not supported.", actionType)
+	}
+	return nil
+}
+
+// validateActionSettings checks that the settings of a rule are legal for its action type.
+func validateActionSettings(actionType ActionType, notificationTime []NotificationTimeType, schedules []Schedule) error {
+	if actionType.IsScheduleAction() && len(notificationTime) == 0 {
+		return errors.New("Notification time cannot be empty.")
+	}
+	if !actionType.IsScheduleAction() && len(notificationTime) != 0 {
+		return errors.Errorf("Notification time can only be set for %s and %s rules.", ScheduleStart, ScheduleEnd)
+	}
+	if !actionType.IsScheduleAction() && len(schedules) != 0 {
+		return errors.Errorf("Schedules can only be set for %s and %s rules.", ScheduleStart, ScheduleEnd)
+	}
+	for _, time := range notificationTime {
+		if !notificationTimes[time] {
+			return errors.Errorf("Notification time %s is not supported.", time)
+		}
+	}
+	return nil
+}
+
+func validateNotifyMethod(method og.MethodType) error {
+	if method == "" {
+		return errors.New("Method cannot be empty.")
+	}
+	if !notifyMethods[method] {
+		return errors.Errorf("Method %s is not supported.", method)
+	}
+	return nil
+}
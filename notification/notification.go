@@ -16,6 +16,12 @@ func NewClient(config *client.Config) (*Client, error) {
 	}
 	return &Client{client: opsgenieClient}, nil
 }
+
+// NewClientFrom returns a client that shares an existing OpsGenieClient, and with it its configuration, transport
+// and retry state.
+func NewClientFrom(opsgenieClient *client.OpsGenieClient) *Client {
+	return &Client{client: opsgenieClient}
+}
 func (c *Client) CreateRuleStep(context context.Context, request *CreateRuleStepRequest) (*CreateRuleStepResult, error) {
 	result := &CreateRuleStepResult{}
 	err := c.client.Exec(context, request, result)
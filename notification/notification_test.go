@@ -201,6 +201,10 @@ func TestCreateRuleRequest_Validate(t *testing.T) {
 
 	notificationTypes := make([]NotificationTimeType, 1)
 	notificationTypes[0] = JustBefore
+	createRequest.NotificationTime = []NotificationTimeType{"2-days-ago"}
+	err = createRequest.Validate()
+	assert.Equal(t, err.Error(), errors.New("Notification time 2-days-ago is not supported.").Error())
+
 	createRequest.NotificationTime = notificationTypes
 	err = createRequest.Validate()
 	assert.Nil(t, err)
@@ -216,7 +220,19 @@ func TestCreateRuleRequest_Validate(t *testing.T) {
 	err = createRequest.Validate()
 	assert.Nil(t, err)
 
+	createRequest.ActionType = "renotified-alert"
+	err = createRequest.Validate()
+	assert.Equal(t, err.Error(), errors.New("Action type renotified-alert is not supported.").Error())
+
 	createRequest.ActionType = CreateAlert
+	err = createRequest.Validate()
+	assert.Equal(t, err.Error(), errors.New("Notification time can only be set for schedule-start and schedule-end rules.").Error())
+
+	createRequest.NotificationTime = nil
+	err = createRequest.Validate()
+	assert.Equal(t, err.Error(), errors.New("Schedules can only be set for schedule-start and schedule-end rules.").Error())
+
+	createRequest.Schedules = nil
 	steps := make([]*og.Step, 1)
 	contact := og.Contact{}
 	steps[0] = &og.Step{Contact: contact}
@@ -230,7 +246,13 @@ func TestCreateRuleRequest_Validate(t *testing.T) {
 	err = createRequest.Validate()
 	assert.Equal(t, err.Error(), errors.New("Method cannot be empty.").Error())
 
-	contact = og.Contact{To: "abc@a.com", MethodOfContact: og.Email}
+	contact = og.Contact{To: "abc@a.com", MethodOfContact: "pager"}
+	steps[0] = &og.Step{Contact: contact}
+	createRequest.Steps = steps
+	err = createRequest.Validate()
+	assert.Equal(t, err.Error(), errors.New("Method pager is not supported.").Error())
+
+	contact = og.Contact{To: "abc@a.com", MethodOfContact: EmailMethod}
 	steps[0] = &og.Step{Contact: contact}
 	createRequest.Steps = steps
 	err = createRequest.Validate()
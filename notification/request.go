@@ -32,7 +32,7 @@ func (r *CreateRuleStepRequest) Validate() error {
 
 func (r *CreateRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps"
 }
 
 func (r *CreateRuleStepRequest) Method() string {
@@ -56,7 +56,7 @@ func (r *GetRuleStepRequest) Validate() error {
 
 func (r *GetRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps/" + r.RuleStepId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps/" + client.PathSegment(r.RuleStepId)
 }
 
 func (r *GetRuleStepRequest) Method() string {
@@ -90,7 +90,7 @@ func (r *UpdateRuleStepRequest) Validate() error {
 
 func (r *UpdateRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps/" + r.RuleStepId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps/" + client.PathSegment(r.RuleStepId)
 }
 
 func (r *UpdateRuleStepRequest) Method() string {
@@ -114,7 +114,7 @@ func (r *DeleteRuleStepRequest) Validate() error {
 
 func (r *DeleteRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps/" + r.RuleStepId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps/" + client.PathSegment(r.RuleStepId)
 }
 
 func (r *DeleteRuleStepRequest) Method() string {
@@ -137,7 +137,7 @@ func (r *ListRuleStepsRequest) Validate() error {
 
 func (r *ListRuleStepsRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps"
 }
 
 func (r *ListRuleStepsRequest) Method() string {
@@ -161,7 +161,7 @@ func (r *EnableRuleStepRequest) Validate() error {
 
 func (r *EnableRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps/" + r.RuleStepId + "/enable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps/" + client.PathSegment(r.RuleStepId) + "/enable"
 }
 
 func (r *EnableRuleStepRequest) Method() string {
@@ -185,7 +185,7 @@ func (r *DisableRuleStepRequest) Validate() error {
 
 func (r *DisableRuleStepRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/steps/" + r.RuleStepId + "/disable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/steps/" + client.PathSegment(r.RuleStepId) + "/disable"
 }
 
 func (r *DisableRuleStepRequest) Method() string {
@@ -217,8 +217,11 @@ func (r *CreateRuleRequest) Validate() error {
 	if r.ActionType == "" {
 		return errors.New("Action type cannot be empty.")
 	}
-	if (r.ActionType == ScheduleStart || r.ActionType == ScheduleEnd) && len(r.NotificationTime) == 0 {
-		return errors.New("Notification time cannot be empty.")
+	if err := validateActionType(r.ActionType); err != nil {
+		return err
+	}
+	if err := validateActionSettings(r.ActionType, r.NotificationTime, r.Schedules); err != nil {
+		return err
 	}
 	if len(r.Schedules) != 0 {
 		for _, schedule := range r.Schedules {
@@ -259,7 +262,7 @@ func (r *CreateRuleRequest) Validate() error {
 
 func (r *CreateRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules"
 }
 
 func (r *CreateRuleRequest) Method() string {
@@ -282,7 +285,7 @@ func (r *GetRuleRequest) Validate() error {
 
 func (r *GetRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId)
 }
 
 func (r *GetRuleRequest) Method() string {
@@ -347,7 +350,7 @@ func (r *UpdateRuleRequest) Validate() error {
 
 func (r *UpdateRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId)
 }
 
 func (r *UpdateRuleRequest) Method() string {
@@ -370,7 +373,7 @@ func (r *DeleteRuleRequest) Validate() error {
 
 func (r *DeleteRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId)
 }
 
 func (r *DeleteRuleRequest) Method() string {
@@ -391,7 +394,7 @@ func (r *ListRuleRequest) Validate() error {
 
 func (r *ListRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules"
 }
 
 func (r *ListRuleRequest) Method() string {
@@ -414,7 +417,7 @@ func (r *EnableRuleRequest) Validate() error {
 
 func (r *EnableRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/enable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/enable"
 }
 
 func (r *EnableRuleRequest) Method() string {
@@ -437,7 +440,7 @@ func (r *DisableRuleRequest) Validate() error {
 
 func (r *DisableRuleRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/" + r.RuleId + "/disable"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/" + client.PathSegment(r.RuleId) + "/disable"
 }
 
 func (r *DisableRuleRequest) Method() string {
@@ -466,7 +469,7 @@ func (r *CopyNotificationRulesRequest) Validate() error {
 
 func (r *CopyNotificationRulesRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.UserIdentifier + "/notification-rules/copy-to"
+	return "/v2/users/" + client.PathSegment(r.UserIdentifier) + "/notification-rules/copy-to"
 }
 
 func (r *CopyNotificationRulesRequest) Method() string {
@@ -504,11 +507,7 @@ func validateContact(contact *og.Contact) error {
 	if contact.To == "" {
 		return errors.New("To cannot be empty.")
 	}
-	if contact.MethodOfContact == "" {
-		return errors.New("Method cannot be empty.")
-
-	}
-	return nil
+	return validateNotifyMethod(contact.MethodOfContact)
 }
 
 type ActionType string
@@ -555,10 +554,10 @@ func validateStep(step *og.Step, actionType ActionType) error {
 	if step.Contact.To == "" {
 		return errors.New("To cannot be empty.")
 	}
-	if step.Contact.MethodOfContact == "" {
-		return errors.New("Method cannot be empty.")
+	if err := validateNotifyMethod(step.Contact.MethodOfContact); err != nil {
+		return err
 	}
-	if (actionType == CreateAlert || actionType == AssignedAlert) && step.SendAfter == nil {
+	if actionType.IsDelayedAction() && step.SendAfter == nil {
 		return errors.New("SendAfter cannot be empty.")
 	}
 
@@ -569,11 +568,7 @@ func validateStepWithoutActionTypeInfo(step *og.Step) error {
 	if step.Contact.To == "" {
 		return errors.New("To cannot be empty.")
 	}
-	if step.Contact.MethodOfContact == "" {
-		return errors.New("Method cannot be empty.")
-	}
-
-	return nil
+	return validateNotifyMethod(step.Contact.MethodOfContact)
 }
 
 type RuleTypes string
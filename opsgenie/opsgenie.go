@@ -0,0 +1,75 @@
+package opsgenie
+
+import (
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/account"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/contact"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/custom_user_role"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/deployment"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/escalation"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/forwarding_rule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/heartbeat"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/incident"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/integration"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/logs"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/maintenance"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/notification"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/policy"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/schedule"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/service"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/user"
+)
+
+// Client holds a client of every API, all sharing a single OpsGenieClient. Shutting down OpsGenieClient shuts down
+// all of them.
+type Client struct {
+	OpsGenieClient *client.OpsGenieClient
+	Account        *account.Client
+	Alert          *alert.Client
+	Contact        *contact.Client
+	CustomUserRole *custom_user_role.Client
+	Deployment     *deployment.Client
+	Escalation     *escalation.Client
+	ForwardingRule *forwarding_rule.Client
+	Heartbeat      *heartbeat.Client
+	Incident       *incident.Client
+	Integration    *integration.Client
+	Logs           *logs.Client
+	Maintenance    *maintenance.Client
+	Notification   *notification.Client
+	Policy         *policy.Client
+	Schedule       *schedule.Client
+	Service        *service.Client
+	Team           *team.Client
+	User           *user.Client
+}
+
+func NewClient(config *client.Config) (*Client, error) {
+	opsgenieClient, err := client.NewOpsGenieClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		OpsGenieClient: opsgenieClient,
+		Account:        account.NewClientFrom(opsgenieClient),
+		Alert:          alert.NewClientFrom(opsgenieClient),
+		Contact:        contact.NewClientFrom(opsgenieClient),
+		CustomUserRole: custom_user_role.NewClientFrom(opsgenieClient),
+		Deployment:     deployment.NewClientFrom(opsgenieClient),
+		Escalation:     escalation.NewClientFrom(opsgenieClient),
+		ForwardingRule: forwarding_rule.NewClientFrom(opsgenieClient),
+		Heartbeat:      heartbeat.NewClientFrom(opsgenieClient),
+		Incident:       incident.NewClientFrom(opsgenieClient),
+		Integration:    integration.NewClientFrom(opsgenieClient),
+		Logs:           logs.NewClientFrom(opsgenieClient),
+		Maintenance:    maintenance.NewClientFrom(opsgenieClient),
+		Notification:   notification.NewClientFrom(opsgenieClient),
+		Policy:         policy.NewClientFrom(opsgenieClient),
+		Schedule:       schedule.NewClientFrom(opsgenieClient),
+		Service:        service.NewClientFrom(opsgenieClient),
+		Team:           team.NewClientFrom(opsgenieClient),
+		User:           user.NewClientFrom(opsgenieClient),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package opsgenie
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(&client.Config{})
+	assert.Equal(t, "API key cannot be blank.", err.Error())
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	opsgenieClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	_, err = opsgenieClient.Heartbeat.Ping(context.Background(), "heartbeat1")
+	assert.Nil(t, err)
+	_, err = opsgenieClient.Alert.Get(context.Background(), &alert.GetAlertRequest{IdentifierValue: "alert1"})
+	assert.Nil(t, err)
+
+	health := opsgenieClient.OpsGenieClient.Health()
+	assert.Equal(t, 2, health.Overall.Requests)
+	assert.Equal(t, 1, health.Domains["heartbeats"].Requests)
+	assert.Equal(t, 1, health.Domains["alerts"].Requests)
+}
@@ -0,0 +1,103 @@
+package opsgenie
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+)
+
+// Registry holds a Client per Opsgenie account, e.g. "prod" and "staging". Metric subscribers are shared by the
+// clients of all accounts; client.AccountFromContext tells which account a metric belongs to.
+type Registry struct {
+	mux     sync.RWMutex
+	clients map[string]*Client
+}
+
+func NewRegistry() *Registry {
+	return &Registry{clients: make(map[string]*Client)}
+}
+
+// Register creates the client of the account with a copy of the config whose Account is set to the account, so one
+// config can be registered for several accounts.
+func (r *Registry) Register(account string, config *client.Config) (*Client, error) {
+	if account == "" {
+		return nil, errors.New("Account cannot be empty.")
+	}
+	if config == nil {
+		return nil, errors.New("Config cannot be empty.")
+	}
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if _, ok := r.clients[account]; ok {
+		return nil, errors.Errorf("Account %s is already registered.", account)
+	}
+	accountConfig := *config
+	accountConfig.Account = account
+	opsgenieClient, err := NewClient(&accountConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not create client of account %s", account)
+	}
+	r.clients[account] = opsgenieClient
+	return opsgenieClient, nil
+}
+
+// Get returns the client of the account.
+func (r *Registry) Get(account string) (*Client, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	opsgenieClient, ok := r.clients[account]
+	return opsgenieClient, ok
+}
+
+// MustGet is Get for accounts known to be registered. It panics otherwise.
+func (r *Registry) MustGet(account string) *Client {
+	opsgenieClient, ok := r.Get(account)
+	if !ok {
+		panic("opsgenie: account " + account + " is not registered")
+	}
+	return opsgenieClient
+}
+
+// Accounts returns the registered accounts in alphabetical order.
+func (r *Registry) Accounts() []string {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	accounts := make([]string, 0, len(r.clients))
+	for account := range r.clients {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// Remove unregisters the account and shuts down its client.
+func (r *Registry) Remove(ctx context.Context, account string) error {
+	r.mux.Lock()
+	opsgenieClient, ok := r.clients[account]
+	delete(r.clients, account)
+	r.mux.Unlock()
+	if !ok {
+		return errors.Errorf("Account %s is not registered.", account)
+	}
+	return opsgenieClient.OpsGenieClient.Shutdown(ctx)
+}
+
+// Shutdown unregisters all accounts and shuts down their clients. The first error is returned after all clients
+// are shut down.
+func (r *Registry) Shutdown(ctx context.Context) error {
+	r.mux.Lock()
+	clients := r.clients
+	r.clients = make(map[string]*Client)
+	r.mux.Unlock()
+
+	var firstErr error
+	for account, opsgenieClient := range clients {
+		if err := opsgenieClient.OpsGenieClient.Shutdown(ctx); err != nil && firstErr == nil {
+			firstErr = errors.Wrapf(err, "Could not shut down client of account %s", account)
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,76 @@
+package opsgenie
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer ts.Close()
+
+	config := func() *client.Config {
+		return &client.Config{
+			ApiKey:         "apiKey",
+			OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+		}
+	}
+
+	registry := NewRegistry()
+	_, err := registry.Register("", config())
+	assert.Equal(t, "Account cannot be empty.", err.Error())
+	_, err = registry.Register("prod", &client.Config{})
+	assert.Equal(t, "Could not create client of account prod: API key cannot be blank.", err.Error())
+
+	prod, err := registry.Register("prod", config())
+	assert.Nil(t, err)
+	assert.Equal(t, "prod", prod.OpsGenieClient.Config.Account)
+	_, err = registry.Register("staging", config())
+	assert.Nil(t, err)
+	_, err = registry.Register("prod", config())
+	assert.Equal(t, "Account prod is already registered.", err.Error())
+	assert.Equal(t, []string{"prod", "staging"}, registry.Accounts())
+
+	staging, ok := registry.Get("staging")
+	assert.True(t, ok)
+	_, err = staging.Heartbeat.Ping(context.Background(), "heartbeat1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), staging.OpsGenieClient.Usage().Total.Calls)
+	assert.Equal(t, int64(0), registry.MustGet("prod").OpsGenieClient.Usage().Total.Calls)
+	_, ok = registry.Get("acquisitions")
+	assert.False(t, ok)
+	assert.Panics(t, func() { registry.MustGet("acquisitions") })
+
+	assert.Nil(t, registry.Remove(context.Background(), "staging"))
+	assert.Equal(t, "Account staging is not registered.", registry.Remove(context.Background(), "staging").Error())
+	_, err = staging.Heartbeat.Ping(context.Background(), "heartbeat1")
+	assert.Equal(t, client.ErrClientClosed, err)
+
+	assert.Nil(t, registry.Shutdown(context.Background()))
+	assert.Empty(t, registry.Accounts())
+	_, err = prod.Heartbeat.Ping(context.Background(), "heartbeat1")
+	assert.Equal(t, client.ErrClientClosed, err)
+}
+
+func TestRegistryCopiesConfig(t *testing.T) {
+	config := &client.Config{ApiKey: "apiKey"}
+	registry := NewRegistry()
+	prod, err := registry.Register("prod", config)
+	assert.Nil(t, err)
+	staging, err := registry.Register("staging", config)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "prod", prod.OpsGenieClient.Config.Account)
+	assert.Equal(t, "staging", staging.OpsGenieClient.Config.Account)
+	assert.Empty(t, config.Account)
+	assert.Nil(t, registry.Shutdown(context.Background()))
+}
@@ -155,7 +155,7 @@ func (r *GetAlertPolicyRequest) Validate() error {
 }
 
 func (r *GetAlertPolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id
+	return "/v2/policies/" + client.PathSegment(r.Id)
 }
 
 func (r *GetAlertPolicyRequest) RequestParams() map[string]string {
@@ -188,7 +188,7 @@ func (r *GetNotificationPolicyRequest) Validate() error {
 }
 
 func (r *GetNotificationPolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id
+	return "/v2/policies/" + client.PathSegment(r.Id)
 }
 
 func (r *GetNotificationPolicyRequest) RequestParams() map[string]string {
@@ -253,7 +253,7 @@ func (r *UpdateAlertPolicyRequest) Validate() error {
 }
 
 func (r *UpdateAlertPolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id
+	return "/v2/policies/" + client.PathSegment(r.Id)
 }
 
 func (r *UpdateAlertPolicyRequest) Method() string {
@@ -311,7 +311,7 @@ func (r *UpdateNotificationPolicyRequest) Validate() error {
 }
 
 func (r *UpdateNotificationPolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id
+	return "/v2/policies/" + client.PathSegment(r.Id)
 }
 
 func (r *UpdateNotificationPolicyRequest) RequestParams() map[string]string {
@@ -343,7 +343,7 @@ func (r *DeletePolicyRequest) Validate() error {
 }
 
 func (r *DeletePolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id
+	return "/v2/policies/" + client.PathSegment(r.Id)
 }
 
 func (r *DeletePolicyRequest) Method() string {
@@ -378,7 +378,7 @@ func (r *DisablePolicyRequest) Validate() error {
 }
 
 func (r *DisablePolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id + "/disable"
+	return "/v2/policies/" + client.PathSegment(r.Id) + "/disable"
 }
 
 func (r *DisablePolicyRequest) Method() string {
@@ -413,7 +413,7 @@ func (r *EnablePolicyRequest) Validate() error {
 }
 
 func (r *EnablePolicyRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id + "/enable"
+	return "/v2/policies/" + client.PathSegment(r.Id) + "/enable"
 }
 
 func (r *EnablePolicyRequest) Method() string {
@@ -452,7 +452,7 @@ func (r *ChangeOrderRequest) Validate() error {
 }
 
 func (r *ChangeOrderRequest) ResourcePath() string {
-	return "/v2/policies/" + r.Id + "/change-order"
+	return "/v2/policies/" + client.PathSegment(r.Id) + "/change-order"
 }
 
 func (r *ChangeOrderRequest) Method() string {
@@ -0,0 +1,298 @@
+package preview
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/escalation"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/pkg/errors"
+)
+
+type Clients struct {
+	Team       *team.Client
+	Escalation *escalation.Client
+}
+
+// Notification is a recipient that would be notified about the alert after Delay.
+type Notification struct {
+	Team        string
+	RoutingRule string
+	Escalation  string
+	Recipient   og.Participant
+	Delay       time.Duration
+	Condition   og.EscalationCondition
+}
+
+type Result struct {
+	Notifications []Notification
+	Warnings      []string
+}
+
+// Preview resolves the responders of the request into the recipients that would be notified if the alert was
+// created, without creating it. Team responders are routed with the first routing rule of the team whose criteria
+// match the alert, and escalations are expanded into their rules. Time restrictions of routing rules are not
+// evaluated.
+func Preview(ctx context.Context, clients *Clients, req *alert.CreateAlertRequest) (*Result, error) {
+	if clients == nil || clients.Team == nil || clients.Escalation == nil {
+		return nil, errors.New("Team and escalation clients cannot be empty.")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, responder := range req.Responders {
+		switch responder.Type {
+		case alert.UserResponder:
+			result.add(Notification{Recipient: og.Participant{Type: og.User, Id: responder.Id, Username: responder.Username}})
+		case alert.ScheduleResponder:
+			result.add(Notification{Recipient: og.Participant{Type: og.Schedule, Id: responder.Id, Name: responder.Name}})
+		case alert.EscalationResponder:
+			if err := result.addEscalation(ctx, clients, Notification{}, responder.Id, responder.Name); err != nil {
+				return nil, err
+			}
+		case alert.TeamResponder:
+			if err := result.addTeam(ctx, clients, req, responder); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.SliceStable(result.Notifications, func(i, j int) bool {
+		return result.Notifications[i].Delay < result.Notifications[j].Delay
+	})
+	return result, nil
+}
+
+func (r *Result) add(notification Notification) {
+	r.Notifications = append(r.Notifications, notification)
+}
+
+func (r *Result) addTeam(ctx context.Context, clients *Clients, req *alert.CreateAlertRequest, responder alert.Responder) error {
+	listRequest := &team.ListRoutingRulesRequest{TeamIdentifierType: team.Id, TeamIdentifierValue: responder.Id}
+	teamName := responder.Id
+	if responder.Id == "" {
+		listRequest = &team.ListRoutingRulesRequest{TeamIdentifierType: team.Name, TeamIdentifierValue: responder.Name}
+		teamName = responder.Name
+	}
+	rules, err := clients.Team.ListRoutingRules(ctx, listRequest)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules.RoutingRules {
+		if !Matches(rule.Criteria, req) {
+			continue
+		}
+		base := Notification{Team: teamName, RoutingRule: rule.Name}
+		switch rule.Notify.Type {
+		case team.EscalationNotifyType:
+			return r.addEscalation(ctx, clients, base, rule.Notify.Id, rule.Notify.Name)
+		case team.ScheduleNotifyType:
+			base.Recipient = og.Participant{Type: og.Schedule, Id: rule.Notify.Id, Name: rule.Notify.Name}
+			r.add(base)
+		default:
+			r.Warnings = append(r.Warnings, "Routing rule "+rule.Name+" of team "+teamName+" does not notify anyone.")
+		}
+		return nil
+	}
+	r.Warnings = append(r.Warnings, "No routing rule of team "+teamName+" matches the alert.")
+	return nil
+}
+
+func (r *Result) addEscalation(ctx context.Context, clients *Clients, base Notification, id string, name string) error {
+	getRequest := &escalation.GetRequest{IdentifierType: escalation.Id, Identifier: id}
+	if id == "" {
+		getRequest = &escalation.GetRequest{IdentifierType: escalation.Name, Identifier: name}
+	}
+	result, err := clients.Escalation.Get(ctx, getRequest)
+	if err != nil {
+		return err
+	}
+	for _, rule := range result.Rules {
+		notification := base
+		notification.Escalation = result.Name
+		notification.Recipient = rule.Recipient
+		notification.Delay = delay(rule.Delay)
+		notification.Condition = rule.Condition
+		r.add(notification)
+	}
+	return nil
+}
+
+func delay(d escalation.EscalationDelay) time.Duration {
+	amount := time.Duration(d.TimeAmount)
+	switch d.TimeUnit {
+	case og.Hours:
+		return amount * time.Hour
+	case og.Days:
+		return amount * 24 * time.Hour
+	case og.Weeks:
+		return amount * 7 * 24 * time.Hour
+	default:
+		return amount * time.Minute
+	}
+}
+
+// Matches reports whether the alert that would be created by the request matches the criteria.
+func Matches(criteria og.Criteria, req *alert.CreateAlertRequest) bool {
+	switch criteria.CriteriaType {
+	case og.MatchAnyCondition:
+		for _, condition := range criteria.Conditions {
+			if matchesCondition(condition, req) {
+				return true
+			}
+		}
+		return false
+	case og.MatchAllConditions:
+		for _, condition := range criteria.Conditions {
+			if !matchesCondition(condition, req) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+func matchesCondition(condition og.Condition, req *alert.CreateAlertRequest) bool {
+	var matched bool
+	switch condition.Field {
+	case og.Tags:
+		matched = matchesList(condition, req.Tags)
+	case og.Actions:
+		matched = matchesList(condition, req.Actions)
+	case og.Teams, og.Recipients:
+		var names []string
+		for _, responder := range req.Responders {
+			if condition.Field == og.Recipients || responder.Type == alert.TeamResponder {
+				names = append(names, responder.Name, responder.Username)
+			}
+		}
+		matched = matchesList(condition, names)
+	case og.Details, og.ExtraProperties:
+		matched = matchesMap(condition, req.Details)
+	default:
+		matched = matchesString(condition.Operation, fieldValue(condition.Field, req), condition.ExpectedValue)
+	}
+	if condition.IsNot != nil && *condition.IsNot {
+		return !matched
+	}
+	return matched
+}
+
+func fieldValue(field og.ConditionFieldType, req *alert.CreateAlertRequest) string {
+	switch field {
+	case og.Message:
+		return req.Message
+	case og.Alias:
+		return req.Alias
+	case og.Description:
+		return req.Description
+	case og.Source:
+		return req.Source
+	case og.Entity:
+		return req.Entity
+	case og.Priority:
+		if req.Priority == "" {
+			return string(alert.P3)
+		}
+		return string(req.Priority)
+	}
+	return ""
+}
+
+func matchesList(condition og.Condition, values []string) bool {
+	if condition.Operation == og.IsEmpty {
+		return len(values) == 0
+	}
+	operation := condition.Operation
+	if operation == og.Contains {
+		operation = og.Equals
+	}
+	for _, value := range values {
+		if value != "" && matchesString(operation, value, condition.ExpectedValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesMap(condition og.Condition, values map[string]string) bool {
+	switch condition.Operation {
+	case og.IsEmpty:
+		if condition.Key == "" {
+			return len(values) == 0
+		}
+	case og.ContainsKey:
+		_, ok := values[condition.ExpectedValue]
+		return ok
+	case og.ContainsValue:
+		for _, value := range values {
+			if value == condition.ExpectedValue {
+				return true
+			}
+		}
+		return false
+	}
+	if condition.Key == "" {
+		return false
+	}
+	return matchesString(condition.Operation, values[condition.Key], condition.ExpectedValue)
+}
+
+func matchesString(operation og.ConditionOperation, value string, expected string) bool {
+	switch operation {
+	case og.Matches:
+		matched, err := regexp.MatchString(expected, value)
+		return err == nil && matched
+	case og.Contains:
+		return strings.Contains(value, expected)
+	case og.StartsWith:
+		return strings.HasPrefix(value, expected)
+	case og.EndsWith:
+		return strings.HasSuffix(value, expected)
+	case og.Equals:
+		return value == expected
+	case og.EqualsIgnoreWhitespcae:
+		return removeWhitespace(value) == removeWhitespace(expected)
+	case og.IsEmpty:
+		return value == ""
+	case og.GreaterThan:
+		return compare(value, expected) > 0
+	case og.LessThan:
+		return compare(value, expected) < 0
+	}
+	return false
+}
+
+func removeWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func compare(value string, expected string) int {
+	v, err1 := strconv.ParseFloat(value, 64)
+	e, err2 := strconv.ParseFloat(expected, 64)
+	if err1 != nil || err2 != nil {
+		return strings.Compare(value, expected)
+	}
+	if v < e {
+		return -1
+	} else if v > e {
+		return 1
+	}
+	return 0
+}
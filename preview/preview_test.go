@@ -0,0 +1,105 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/escalation"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/team"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreview(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/teams/ops/routing-rules":
+			fmt.Fprint(w, `{"data": [
+				{"id": "r1", "name": "critical", "criteria": {"type": "match-all-conditions", "conditions": [
+					{"field": "tags", "operation": "contains", "expectedValue": "critical"},
+					{"field": "priority", "operation": "less-than", "expectedValue": "P3"}
+				]}, "notify": {"type": "escalation", "id": "e1"}},
+				{"id": "r2", "name": "default", "isDefault": true, "criteria": {"type": "match-all"}, "notify": {"type": "schedule", "name": "ops_schedule"}}
+			], "took": 0.1, "requestId": "rId"}`)
+		case "/v2/escalations/e1":
+			fmt.Fprint(w, `{"data": {"id": "e1", "name": "ops_escalation", "rules": [
+				{"condition": "if-not-acked", "notifyType": "default", "recipient": {"type": "schedule", "name": "ops_schedule"}, "delay": {"timeAmount": 0, "timeUnit": "minutes"}},
+				{"condition": "if-not-acked", "notifyType": "default", "recipient": {"type": "user", "username": "lead@example.com"}, "delay": {"timeAmount": 1, "timeUnit": "hours"}}
+			]}, "took": 0.1, "requestId": "rId"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message": "not found", "took": 0.1, "requestId": "rId"}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	}
+	teamClient, err := team.NewClient(config)
+	assert.Nil(t, err)
+	escalationClient, err := escalation.NewClient(config)
+	assert.Nil(t, err)
+	clients := &Clients{Team: teamClient, Escalation: escalationClient}
+
+	req := &alert.CreateAlertRequest{
+		Message:    "Database is down",
+		Tags:       []string{"critical", "db"},
+		Priority:   alert.P1,
+		Responders: []alert.Responder{{Type: alert.TeamResponder, Name: "ops"}, {Type: alert.UserResponder, Username: "oncall@example.com"}},
+	}
+	result, err := Preview(context.Background(), clients, req)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Warnings)
+	assert.Equal(t, 3, len(result.Notifications))
+	assert.Equal(t, Notification{
+		Team:        "ops",
+		RoutingRule: "critical",
+		Escalation:  "ops_escalation",
+		Recipient:   og.Participant{Type: og.Schedule, Name: "ops_schedule"},
+		Condition:   og.IfNotAcked,
+	}, result.Notifications[0])
+	assert.Equal(t, og.User, result.Notifications[1].Recipient.Type)
+	assert.Equal(t, "oncall@example.com", result.Notifications[1].Recipient.Username)
+	assert.Equal(t, time.Hour, result.Notifications[2].Delay)
+
+	req.Priority = alert.P4
+	result, err = Preview(context.Background(), clients, req)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result.Notifications))
+	assert.Equal(t, "default", result.Notifications[0].RoutingRule)
+
+	_, err = Preview(context.Background(), clients, &alert.CreateAlertRequest{})
+	assert.NotNil(t, err)
+}
+
+func TestMatches(t *testing.T) {
+	not := true
+	req := &alert.CreateAlertRequest{
+		Message: "Disk  full on host-1",
+		Details: map[string]string{"region": "eu-west"},
+	}
+	assert.True(t, Matches(og.Criteria{CriteriaType: og.MatchAll}, req))
+	assert.True(t, Matches(og.Criteria{CriteriaType: og.MatchAnyCondition, Conditions: []og.Condition{
+		{Field: og.Message, Operation: og.StartsWith, ExpectedValue: "CPU"},
+		{Field: og.Message, Operation: og.Matches, ExpectedValue: "host-\\d+$"},
+	}}, req))
+	assert.True(t, Matches(og.Criteria{CriteriaType: og.MatchAllConditions, Conditions: []og.Condition{
+		{Field: og.Message, Operation: og.EqualsIgnoreWhitespcae, ExpectedValue: "Disk full onhost-1"},
+		{Field: og.Details, Operation: og.ContainsKey, ExpectedValue: "region"},
+		{Field: og.Details, Key: "region", Operation: og.StartsWith, ExpectedValue: "eu"},
+		{Field: og.Tags, Operation: og.IsEmpty},
+		{Field: og.Priority, Operation: og.Equals, ExpectedValue: "P3"},
+	}}, req))
+	assert.False(t, Matches(og.Criteria{CriteriaType: og.MatchAllConditions, Conditions: []og.Condition{
+		{Field: og.Message, Operation: og.Contains, ExpectedValue: "Disk", IsNot: &not},
+	}}, req))
+}
@@ -0,0 +1,371 @@
+package schedule
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// IcsEvent is a VEVENT of an ICS calendar.
+type IcsEvent struct {
+	Uid       string
+	Summary   string
+	Start     time.Time
+	End       time.Time
+	Organizer string
+	Attendees []string
+	Cancelled bool
+}
+
+// IcsImportOptions configures how IcsOverrides converts calendar events into schedule overrides.
+type IcsImportOptions struct {
+	ScheduleIdentifierType Identifier
+	ScheduleIdentifier     string
+	Rotations              []RotationIdentifier
+	// Location is used for times without a time zone, for all day events and for time zones that are neither known
+	// nor defined by the calendar. Defaults to UTC.
+	Location *time.Location
+	// User returns the user covering the event. Defaults to the first attendee, or the organizer if the event has
+	// no attendees.
+	User func(event IcsEvent) (Responder, error)
+}
+
+// ParseIcs parses the events of an ICS calendar. Recurring events are not supported. Time zones are looked up by
+// their IANA or Windows names; other zones use the standard offset of their VTIMEZONE definition, or location if the
+// calendar doesn't define them. All day events without an end last one day.
+func ParseIcs(reader io.Reader, location *time.Location) ([]IcsEvent, error) {
+	if location == nil {
+		location = time.UTC
+	}
+	lines, err := unfoldIcsLines(reader)
+	if err != nil {
+		return nil, err
+	}
+	zones := icsTimeZones(lines)
+	var events []IcsEvent
+	var event *IcsEvent
+	var duration string
+	allDay := false
+	nested := 0 // depth of the components inside the event, such as alarms
+	for _, line := range lines {
+		name, params, value := parseIcsLine(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = &IcsEvent{}
+			duration = ""
+			allDay = false
+			nested = 0
+		case event == nil:
+			continue
+		case name == "BEGIN":
+			nested++
+		case nested > 0:
+			if name == "END" {
+				nested--
+			}
+		case name == "END" && value == "VEVENT":
+			if event.End.IsZero() && duration != "" {
+				d, err := parseIcsDuration(duration)
+				if err != nil {
+					return nil, errors.Wrapf(err, "Event %s has an invalid duration", event.Uid)
+				}
+				event.End = event.Start.Add(d)
+			}
+			if event.End.IsZero() && allDay {
+				event.End = event.Start.AddDate(0, 0, 1)
+			}
+			events = append(events, *event)
+			event = nil
+		case name == "UID":
+			event.Uid = value
+		case name == "SUMMARY":
+			event.Summary = unescapeIcsText(value)
+		case name == "STATUS":
+			event.Cancelled = strings.EqualFold(value, "CANCELLED")
+		case name == "ORGANIZER":
+			event.Organizer = icsAddress(value)
+		case name == "ATTENDEE":
+			event.Attendees = append(event.Attendees, icsAddress(value))
+		case name == "DTSTART", name == "DTEND":
+			date, err := parseIcsTime(value, params, location, zones)
+			if err != nil {
+				return nil, errors.Wrapf(err, "Event %s has an invalid %s", event.Uid, name)
+			}
+			if name == "DTSTART" {
+				event.Start = date
+				allDay = isIcsDate(value, params)
+			} else {
+				event.End = date
+			}
+		case name == "DURATION":
+			duration = value
+		case name == "RRULE", name == "RDATE":
+			return nil, errors.Errorf("Recurring event %s is not supported.", event.Uid)
+		}
+	}
+	return events, nil
+}
+
+// IcsOverrides converts the events of an ICS calendar into schedule override requests, which can be applied with
+// ApplyOverrides. Cancelled events are skipped. The UID of an event is used as the alias of its override.
+func IcsOverrides(reader io.Reader, options IcsImportOptions) ([]*CreateScheduleOverrideRequest, error) {
+	if options.ScheduleIdentifier == "" {
+		return nil, errors.New("Schedule identifier cannot be empty.")
+	}
+	events, err := ParseIcs(reader, options.Location)
+	if err != nil {
+		return nil, err
+	}
+	userOf := options.User
+	if userOf == nil {
+		userOf = icsEventUser
+	}
+	requests := make([]*CreateScheduleOverrideRequest, 0, len(events))
+	for _, event := range events {
+		if event.Cancelled {
+			continue
+		}
+		user, err := userOf(event)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not determine the user of event %s", event.Uid)
+		}
+		if event.Start.IsZero() || !event.End.After(event.Start) {
+			return nil, errors.Errorf("Event %s should end after it starts.", event.Uid)
+		}
+		requests = append(requests, &CreateScheduleOverrideRequest{
+			Alias:                  event.Uid,
+			User:                   user,
+			StartDate:              event.Start,
+			EndDate:                event.End,
+			Rotations:              options.Rotations,
+			ScheduleIdentifierType: options.ScheduleIdentifierType,
+			ScheduleIdentifier:     options.ScheduleIdentifier,
+		})
+	}
+	return requests, nil
+}
+
+func icsEventUser(event IcsEvent) (Responder, error) {
+	username := event.Organizer
+	if len(event.Attendees) > 0 {
+		username = event.Attendees[0]
+	}
+	if username == "" {
+		return Responder{}, errors.New("Event has neither attendees nor an organizer.")
+	}
+	return Responder{Type: UserResponderType, Username: username}, nil
+}
+
+// unfoldIcsLines joins the continuation lines of a calendar, which start with a space or a tab, to their line.
+func unfoldIcsLines(reader io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "Could not read the calendar")
+	}
+	return lines, nil
+}
+
+// parseIcsLine splits a content line into its upper cased name, its parameters and its value.
+func parseIcsLine(line string) (string, map[string]string, string) {
+	params := make(map[string]string)
+	separator := strings.Index(line, ":")
+	if separator < 0 {
+		return strings.ToUpper(line), params, ""
+	}
+	head, value := line[:separator], line[separator+1:]
+	parts := strings.Split(head, ";")
+	for _, param := range parts[1:] {
+		if i := strings.Index(param, "="); i > 0 {
+			params[strings.ToUpper(param[:i])] = strings.Trim(param[i+1:], `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+func isIcsDate(value string, params map[string]string) bool {
+	return params["VALUE"] == "DATE" || len(value) == len("20060102")
+}
+
+func parseIcsTime(value string, params map[string]string, location *time.Location, zones map[string]*time.Location) (time.Time, error) {
+	if isIcsDate(value, params) {
+		return time.ParseInLocation("20060102", value, location)
+	}
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse("20060102T150405Z", value)
+	}
+	if tzid, ok := params["TZID"]; ok {
+		location = icsLocation(tzid, location, zones)
+	}
+	return time.ParseInLocation("20060102T150405", value, location)
+}
+
+// icsLocation returns the time zone with the IANA or Windows name, the zone defined by the calendar or location.
+func icsLocation(tzid string, location *time.Location, zones map[string]*time.Location) *time.Location {
+	if zone, err := time.LoadLocation(tzid); err == nil {
+		return zone
+	}
+	if name, ok := windowsTimeZones[tzid]; ok {
+		if zone, err := time.LoadLocation(name); err == nil {
+			return zone
+		}
+	}
+	if zone, ok := zones[tzid]; ok {
+		return zone
+	}
+	return location
+}
+
+// icsTimeZones returns the time zones defined by the VTIMEZONE components of a calendar at their standard offset.
+// Their daylight saving rules are not applied.
+func icsTimeZones(lines []string) map[string]*time.Location {
+	zones := make(map[string]*time.Location)
+	var tzid, component string
+	for _, line := range lines {
+		name, _, value := parseIcsLine(line)
+		switch {
+		case name == "BEGIN" && value == "VTIMEZONE":
+			tzid, component = "", ""
+		case name == "END" && value == "VTIMEZONE":
+			tzid = ""
+		case name == "TZID":
+			tzid = value
+		case name == "BEGIN":
+			component = value
+		case name == "END":
+			component = ""
+		case name == "TZOFFSETTO" && tzid != "" && component == "STANDARD":
+			if offset, ok := parseIcsOffset(value); ok {
+				zones[tzid] = time.FixedZone(tzid, offset)
+			}
+		}
+	}
+	return zones
+}
+
+// parseIcsOffset parses a UTC offset such as -0800 or +053000 into seconds.
+func parseIcsOffset(value string) (int, bool) {
+	if len(value) != 5 && len(value) != 7 || value[0] != '+' && value[0] != '-' {
+		return 0, false
+	}
+	digits := value[1:] + "00"
+	hours, errHours := strconv.Atoi(digits[0:2])
+	minutes, errMinutes := strconv.Atoi(digits[2:4])
+	seconds, errSeconds := strconv.Atoi(digits[4:6])
+	if errHours != nil || errMinutes != nil || errSeconds != nil {
+		return 0, false
+	}
+	offset := hours*3600 + minutes*60 + seconds
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// windowsTimeZones maps the Windows names of common time zones, which Outlook and Exchange use as TZID, to their IANA
+// names.
+var windowsTimeZones = map[string]string{
+	"Dateline Standard Time":         "Etc/GMT+12",
+	"Hawaiian Standard Time":         "Pacific/Honolulu",
+	"Alaskan Standard Time":          "America/Anchorage",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"US Mountain Standard Time":      "America/Phoenix",
+	"Mountain Standard Time":         "America/Denver",
+	"Central Standard Time":          "America/Chicago",
+	"Central America Standard Time":  "America/Guatemala",
+	"Canada Central Standard Time":   "America/Regina",
+	"Mexico Standard Time":           "America/Mexico_City",
+	"Central Standard Time (Mexico)": "America/Mexico_City",
+	"Eastern Standard Time":          "America/New_York",
+	"US Eastern Standard Time":       "America/Indianapolis",
+	"SA Pacific Standard Time":       "America/Bogota",
+	"Atlantic Standard Time":         "America/Halifax",
+	"Newfoundland Standard Time":     "America/St_Johns",
+	"E. South America Standard Time": "America/Sao_Paulo",
+	"Argentina Standard Time":        "America/Buenos_Aires",
+	"GMT Standard Time":              "Europe/London",
+	"Greenwich Standard Time":        "Atlantic/Reykjavik",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"Central European Standard Time": "Europe/Warsaw",
+	"Romance Standard Time":          "Europe/Paris",
+	"E. Europe Standard Time":        "Europe/Chisinau",
+	"FLE Standard Time":              "Europe/Kiev",
+	"GTB Standard Time":              "Europe/Bucharest",
+	"Turkey Standard Time":           "Europe/Istanbul",
+	"Israel Standard Time":           "Asia/Jerusalem",
+	"South Africa Standard Time":     "Africa/Johannesburg",
+	"Russian Standard Time":          "Europe/Moscow",
+	"Arabian Standard Time":          "Asia/Dubai",
+	"Arab Standard Time":             "Asia/Riyadh",
+	"Iran Standard Time":             "Asia/Tehran",
+	"Pakistan Standard Time":         "Asia/Karachi",
+	"India Standard Time":            "Asia/Calcutta",
+	"Nepal Standard Time":            "Asia/Katmandu",
+	"Bangladesh Standard Time":       "Asia/Dhaka",
+	"SE Asia Standard Time":          "Asia/Bangkok",
+	"China Standard Time":            "Asia/Shanghai",
+	"Singapore Standard Time":        "Asia/Singapore",
+	"Taipei Standard Time":           "Asia/Taipei",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"Korea Standard Time":            "Asia/Seoul",
+	"W. Australia Standard Time":     "Australia/Perth",
+	"Cen. Australia Standard Time":   "Australia/Adelaide",
+	"AUS Central Standard Time":      "Australia/Darwin",
+	"E. Australia Standard Time":     "Australia/Brisbane",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+	"Tasmania Standard Time":         "Australia/Hobart",
+	"New Zealand Standard Time":      "Pacific/Auckland",
+}
+
+var icsDurationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+func parseIcsDuration(value string) (time.Duration, error) {
+	match := icsDurationPattern.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, errors.Errorf("Duration %s is not valid.", value)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(n) * unit
+	}
+	if match[1] == "-" {
+		duration = -duration
+	}
+	return duration, nil
+}
+
+func icsAddress(value string) string {
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		return value[len("mailto:"):]
+	}
+	return value
+}
+
+func unescapeIcsText(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
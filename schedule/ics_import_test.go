@@ -0,0 +1,152 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const coverageCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cover-1@example.com\r\n" +
+	"SUMMARY:Cover for Jane\\, weekend\r\n" +
+	"DTSTART:20191221T090000Z\r\n" +
+	"DTEND:20191222T090000Z\r\n" +
+	"ORGANIZER;CN=Jane:mailto:jane@example.com\r\n" +
+	"ATTENDEE;CN=John;ROLE=REQ-PARTICIPANT:mailto:john@exam\r\n" +
+	" ple.com\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"DURATION:PT5M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cover-2@example.com\r\n" +
+	"DTSTART;TZID=Europe/Istanbul:20191224T080000\r\n" +
+	"DURATION:P1DT4H\r\n" +
+	"ORGANIZER:mailto:jane@example.com\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cover-3@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20191231\r\n" +
+	"DTEND;VALUE=DATE:20200101\r\n" +
+	"ORGANIZER:mailto:jane@example.com\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseIcs(t *testing.T) {
+	events, err := ParseIcs(strings.NewReader(coverageCalendar), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(events))
+
+	assert.Equal(t, "cover-1@example.com", events[0].Uid)
+	assert.Equal(t, "Cover for Jane, weekend", events[0].Summary)
+	assert.Equal(t, "jane@example.com", events[0].Organizer)
+	assert.Equal(t, []string{"john@example.com"}, events[0].Attendees)
+	assert.Equal(t, time.Date(2019, 12, 21, 9, 0, 0, 0, time.UTC), events[0].Start)
+	assert.Equal(t, time.Date(2019, 12, 22, 9, 0, 0, 0, time.UTC), events[0].End)
+
+	assert.Equal(t, time.Date(2019, 12, 24, 5, 0, 0, 0, time.UTC), events[1].Start.UTC())
+	assert.Equal(t, 28*time.Hour, events[1].End.Sub(events[1].Start))
+
+	assert.True(t, events[2].Cancelled)
+	assert.Equal(t, time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC), events[2].Start)
+}
+
+func TestParseIcsWithRecurringEvent(t *testing.T) {
+	calendar := "BEGIN:VEVENT\nUID:weekly\nDTSTART:20191221T090000Z\nRRULE:FREQ=WEEKLY\nEND:VEVENT\n"
+	_, err := ParseIcs(strings.NewReader(calendar), nil)
+	assert.Equal(t, "Recurring event weekly is not supported.", err.Error())
+}
+
+func TestIcsOverrides(t *testing.T) {
+	_, err := IcsOverrides(strings.NewReader(coverageCalendar), IcsImportOptions{})
+	assert.Equal(t, "Schedule identifier cannot be empty.", err.Error())
+
+	requests, err := IcsOverrides(strings.NewReader(coverageCalendar), IcsImportOptions{
+		ScheduleIdentifierType: Name,
+		ScheduleIdentifier:     "ops",
+		Rotations:              []RotationIdentifier{{Name: "weekdays"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(requests))
+	for _, request := range requests {
+		assert.Nil(t, request.Validate())
+		assert.Equal(t, "ops", request.ScheduleIdentifier)
+		assert.Equal(t, []RotationIdentifier{{Name: "weekdays"}}, request.Rotations)
+	}
+	assert.Equal(t, "cover-1@example.com", requests[0].Alias)
+	assert.Equal(t, Responder{Type: UserResponderType, Username: "john@example.com"}, requests[0].User)
+	assert.Equal(t, Responder{Type: UserResponderType, Username: "jane@example.com"}, requests[1].User)
+
+	requests, err = IcsOverrides(strings.NewReader(coverageCalendar), IcsImportOptions{
+		ScheduleIdentifier: "ops",
+		User: func(event IcsEvent) (Responder, error) {
+			return Responder{Type: UserResponderType, Id: "u-" + event.Uid}, nil
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "u-cover-2@example.com", requests[1].User.Id)
+}
+
+func TestParseIcsFromOutlook(t *testing.T) {
+	calendar := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:Customized Time Zone\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:16010101T030000\r\n" +
+		"TZOFFSETFROM:+0200\r\n" +
+		"TZOFFSETTO:+0100\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:16010101T020000\r\n" +
+		"TZOFFSETFROM:+0100\r\n" +
+		"TZOFFSETTO:+0200\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:outlook-1\r\n" +
+		"DTSTART;TZID=\"Pacific Standard Time\":20191224T080000\r\n" +
+		"DTEND;TZID=\"Pacific Standard Time\":20191224T200000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:outlook-2\r\n" +
+		"DTSTART;TZID=Customized Time Zone:20191224T080000\r\n" +
+		"DTEND;TZID=Customized Time Zone:20191224T200000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:outlook-3\r\n" +
+		"DTSTART;TZID=Unknown Time Zone:20191224T080000\r\n" +
+		"DTEND;TZID=Unknown Time Zone:20191224T200000\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:all-day\r\n" +
+		"DTSTART;VALUE=DATE:20191225\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	assert.Nil(t, err)
+	events, err := ParseIcs(strings.NewReader(calendar), istanbul)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(events))
+
+	assert.Equal(t, time.Date(2019, 12, 24, 16, 0, 0, 0, time.UTC), events[0].Start.UTC())
+	assert.Equal(t, time.Date(2019, 12, 24, 7, 0, 0, 0, time.UTC), events[1].Start.UTC())
+	assert.Equal(t, time.Date(2019, 12, 24, 5, 0, 0, 0, time.UTC), events[2].Start.UTC())
+	assert.Equal(t, time.Date(2019, 12, 25, 0, 0, 0, 0, istanbul), events[3].Start)
+	assert.Equal(t, time.Date(2019, 12, 26, 0, 0, 0, 0, istanbul), events[3].End)
+
+	requests, err := IcsOverrides(strings.NewReader(calendar), IcsImportOptions{
+		ScheduleIdentifier: "ops",
+		User: func(event IcsEvent) (Responder, error) {
+			return Responder{Type: UserResponderType, Username: "john@example.com"}, nil
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(requests))
+}
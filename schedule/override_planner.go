@@ -0,0 +1,207 @@
+package schedule
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/pkg/errors"
+)
+
+// OverrideConflictError is returned by ApplyOverrides when an override overlaps another planned override or an
+// existing override of the same schedule and rotations.
+type OverrideConflictError struct {
+	Index         int
+	ConflictsWith string
+}
+
+func (e *OverrideConflictError) Error() string {
+	return "Override " + strconv.Itoa(e.Index) + " conflicts with " + e.ConflictsWith + "."
+}
+
+// ApplyOverridesError is returned by ApplyOverrides when creating an override failed. The overrides created before
+// it are deleted again; failures of those deletions are listed in RollbackErrors.
+type ApplyOverridesError struct {
+	Index          int
+	Err            error
+	RollbackErrors []error
+}
+
+func (e *ApplyOverridesError) Error() string {
+	message := "Override " + strconv.Itoa(e.Index) + " could not be created: " + e.Err.Error()
+	if len(e.RollbackErrors) > 0 {
+		message += " (" + strconv.Itoa(len(e.RollbackErrors)) + " created overrides could not be rolled back)"
+	}
+	return message
+}
+
+func (e *ApplyOverridesError) Cause() error {
+	return e.Err
+}
+
+// overrideRollbackTimeout bounds the rollback of the overrides created before a failure. The rollback doesn't end
+// with the context of the call, which may be what made the creation fail.
+const overrideRollbackTimeout = 30 * time.Second
+
+type plannedOverride struct {
+	index     int
+	request   *CreateScheduleOverrideRequest
+	rotations []string
+}
+
+// plannedSchedule is a schedule of the planned overrides. Rotations referenced by name are resolved to their ids,
+// so overrides referencing the schedule and its rotations by id or by name are checked against each other.
+type plannedSchedule struct {
+	rotationIds map[string]string
+	existing    []ScheduleOverride
+	planned     []plannedOverride
+}
+
+// ApplyOverrides validates the overrides, checks them for conflicts with each other and with the existing overrides
+// of their schedules, and creates them in order. If one of them cannot be created, the ones created before it are
+// deleted. The aliases of the created overrides are returned in the order of the requests.
+func (c *Client) ApplyOverrides(ctx context.Context, requests []*CreateScheduleOverrideRequest) ([]string, error) {
+	if err := c.checkOverrides(ctx, requests); err != nil {
+		return nil, err
+	}
+
+	aliases := make([]string, 0, len(requests))
+	for i, request := range requests {
+		result, err := c.CreateScheduleOverride(ctx, request)
+		if err != nil {
+			rollbackCtx, cancel := context.WithTimeout(client.WithoutCancel(ctx), overrideRollbackTimeout)
+			rollbackErrors := c.rollbackOverrides(rollbackCtx, requests[:i], aliases)
+			cancel()
+			return nil, &ApplyOverridesError{Index: i, Err: err, RollbackErrors: rollbackErrors}
+		}
+		aliases = append(aliases, result.Alias)
+	}
+	return aliases, nil
+}
+
+func (c *Client) checkOverrides(ctx context.Context, requests []*CreateScheduleOverrideRequest) error {
+	// Schedules are looked up by the identifiers of the requests and by their ids.
+	schedules := make(map[string]*plannedSchedule)
+	for i, request := range requests {
+		if request == nil {
+			return errors.Errorf("Override %d cannot be empty.", i)
+		}
+		if err := request.Validate(); err != nil {
+			return errors.Wrapf(err, "Override %d is invalid", i)
+		}
+		if !request.EndDate.After(request.StartDate) {
+			return errors.Errorf("Override %d should end after it starts.", i)
+		}
+
+		schedule, err := c.plannedSchedule(ctx, schedules, request.ScheduleIdentifierType, request.ScheduleIdentifier)
+		if err != nil {
+			return err
+		}
+		override := plannedOverride{index: i, request: request, rotations: schedule.resolve(request.Rotations)}
+
+		for _, existing := range schedule.existing {
+			if overlaps(override, existing.StartDate, existing.EndDate, schedule.resolve(existing.Rotations)) {
+				return &OverrideConflictError{Index: i, ConflictsWith: "existing override " + existing.Alias}
+			}
+		}
+		for _, other := range schedule.planned {
+			if overlaps(override, other.request.StartDate, other.request.EndDate, other.rotations) {
+				return &OverrideConflictError{Index: i, ConflictsWith: "override " + strconv.Itoa(other.index)}
+			}
+		}
+		schedule.planned = append(schedule.planned, override)
+	}
+	return nil
+}
+
+// plannedSchedule returns the schedule with the identifier, loading it and its existing overrides when it is
+// referenced for the first time.
+func (c *Client) plannedSchedule(ctx context.Context, schedules map[string]*plannedSchedule, identifierType Identifier, identifier string) (*plannedSchedule, error) {
+	key := scheduleKey(identifierType, identifier)
+	if schedule, ok := schedules[key]; ok {
+		return schedule, nil
+	}
+	result, err := c.Get(ctx, &GetRequest{IdentifierType: identifierType, IdentifierValue: identifier})
+	if err != nil {
+		return nil, err
+	}
+	idKey := scheduleKey(Id, result.Schedule.Id)
+	schedule, ok := schedules[idKey]
+	if !ok {
+		overrides, err := c.ListScheduleOverride(ctx, &ListScheduleOverrideRequest{
+			ScheduleIdentifierType: Id,
+			ScheduleIdentifier:     result.Schedule.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		schedule = &plannedSchedule{rotationIds: make(map[string]string), existing: overrides.ScheduleOverride}
+		for _, rotation := range result.Schedule.Rotations {
+			if rotation.Name != "" && rotation.Id != "" {
+				schedule.rotationIds[rotation.Name] = rotation.Id
+			}
+		}
+		schedules[idKey] = schedule
+	}
+	schedules[key] = schedule
+	return schedule, nil
+}
+
+// resolve returns the ids of the rotations, falling back to their names when a name doesn't belong to a rotation
+// of the schedule.
+func (s *plannedSchedule) resolve(rotations []RotationIdentifier) []string {
+	resolved := make([]string, 0, len(rotations))
+	for _, rotation := range rotations {
+		switch {
+		case rotation.Id != "":
+			resolved = append(resolved, "id:"+rotation.Id)
+		case s.rotationIds[rotation.Name] != "":
+			resolved = append(resolved, "id:"+s.rotationIds[rotation.Name])
+		default:
+			resolved = append(resolved, "name:"+rotation.Name)
+		}
+	}
+	return resolved
+}
+
+func (c *Client) rollbackOverrides(ctx context.Context, requests []*CreateScheduleOverrideRequest, aliases []string) []error {
+	var rollbackErrors []error
+	for i := len(aliases) - 1; i >= 0; i-- {
+		_, err := c.DeleteScheduleOverride(ctx, &DeleteScheduleOverrideRequest{
+			ScheduleIdentifierType: requests[i].ScheduleIdentifierType,
+			ScheduleIdentifier:     requests[i].ScheduleIdentifier,
+			Alias:                  aliases[i],
+		})
+		if err != nil {
+			rollbackErrors = append(rollbackErrors, err)
+		}
+	}
+	return rollbackErrors
+}
+
+func scheduleKey(identifierType Identifier, identifier string) string {
+	if identifierType == Name {
+		return "name:" + identifier
+	}
+	return "id:" + identifier
+}
+
+// overlaps reports whether the override overlaps the given period on a common rotation. Overrides without rotations
+// apply to all rotations of the schedule.
+func overlaps(override plannedOverride, start, end time.Time, rotations []string) bool {
+	if !override.request.StartDate.Before(end) || !start.Before(override.request.EndDate) {
+		return false
+	}
+	if len(override.rotations) == 0 || len(rotations) == 0 {
+		return true
+	}
+	for _, a := range override.rotations {
+		for _, b := range rotations {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
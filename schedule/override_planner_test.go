@@ -0,0 +1,163 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func overrideRequest(start time.Time, hours int, rotation string) *CreateScheduleOverrideRequest {
+	request := &CreateScheduleOverrideRequest{
+		ScheduleIdentifierType: Name,
+		ScheduleIdentifier:     "ops",
+		User:                   Responder{Type: UserResponderType, Username: "john@example.com"},
+		StartDate:              start,
+		EndDate:                start.Add(time.Duration(hours) * time.Hour),
+	}
+	if rotation != "" {
+		request.Rotations = []RotationIdentifier{{Name: rotation}}
+	}
+	return request
+}
+
+type overrideServer struct {
+	created, deleted []string
+	failAt           int
+	// onFail is called before the creation at failAt fails.
+	onFail func()
+}
+
+func (s *overrideServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		if !strings.HasSuffix(r.URL.Path, "/overrides") {
+			fmt.Fprint(w, `{"data": {"id": "s1", "name": "ops", "rotations": [{"id": "r1", "name": "weekdays"}, {"id": "r2", "name": "weekends"}]}, "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"alias": "existing", "startDate": "2019-12-24T00:00:00Z", "endDate": "2019-12-25T00:00:00Z", "rotations": [{"id": "r1", "name": "weekdays"}]}], "took": 0.1, "requestId": "rId"}`)
+	case http.MethodPost:
+		if len(s.created) == s.failAt {
+			if s.onFail != nil {
+				s.onFail()
+			}
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message": "invalid user", "took": 0.1, "requestId": "rId"}`)
+			return
+		}
+		alias := fmt.Sprintf("override-%d", len(s.created))
+		s.created = append(s.created, alias)
+		fmt.Fprintf(w, `{"data": {"alias": "%s"}, "took": 0.1, "requestId": "rId"}`, alias)
+	case http.MethodDelete:
+		s.deleted = append(s.deleted, strings.TrimPrefix(r.URL.Path, "/v2/schedules/ops/overrides/"))
+		fmt.Fprint(w, `{"result": "Deleted", "took": 0.1, "requestId": "rId"}`)
+	}
+}
+
+func TestApplyOverrides(t *testing.T) {
+	server := &overrideServer{failAt: -1}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	scheduleClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	christmasEve := time.Date(2019, 12, 24, 12, 0, 0, 0, time.UTC)
+	christmas := time.Date(2019, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	_, err = scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{
+		overrideRequest(christmas, 24, ""),
+		overrideRequest(christmas.Add(12*time.Hour), 24, ""),
+	})
+	assert.EqualError(t, err, "Override 1 conflicts with override 0.")
+
+	_, err = scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{
+		overrideRequest(christmasEve, 6, "weekdays"),
+	})
+	assert.EqualError(t, err, "Override 0 conflicts with existing override existing.")
+	assert.Empty(t, server.created)
+
+	aliases, err := scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{
+		overrideRequest(christmasEve, 6, "weekends"),
+		overrideRequest(christmas, 24, ""),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"override-0", "override-1"}, aliases)
+
+	server.created = nil
+	server.failAt = 2
+	_, err = scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{
+		overrideRequest(christmas, 24, ""),
+		overrideRequest(christmas.Add(24*time.Hour), 24, ""),
+		overrideRequest(christmas.Add(48*time.Hour), 24, ""),
+	})
+	applyErr, ok := err.(*ApplyOverridesError)
+	assert.True(t, ok)
+	assert.Equal(t, 2, applyErr.Index)
+	assert.Empty(t, applyErr.RollbackErrors)
+	assert.Equal(t, []string{"override-1", "override-0"}, server.deleted)
+}
+
+func TestApplyOverridesReconcilesIdsWithNames(t *testing.T) {
+	ts := httptest.NewServer(&overrideServer{failAt: -1})
+	defer ts.Close()
+
+	scheduleClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	christmas := time.Date(2019, 12, 25, 0, 0, 0, 0, time.UTC)
+	byId := overrideRequest(christmas.Add(12*time.Hour), 24, "")
+	byId.ScheduleIdentifierType = Id
+	byId.ScheduleIdentifier = "s1"
+	byId.Rotations = []RotationIdentifier{{Id: "r2"}}
+
+	_, err = scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{
+		overrideRequest(christmas, 24, "weekends"),
+		byId,
+	})
+	assert.EqualError(t, err, "Override 1 conflicts with override 0.")
+
+	existing := overrideRequest(christmas.Add(-6*time.Hour), 12, "")
+	existing.ScheduleIdentifierType = Id
+	existing.ScheduleIdentifier = "s1"
+	existing.Rotations = []RotationIdentifier{{Id: "r1"}}
+	_, err = scheduleClient.ApplyOverrides(context.Background(), []*CreateScheduleOverrideRequest{existing})
+	assert.EqualError(t, err, "Override 0 conflicts with existing override existing.")
+}
+
+func TestApplyOverridesRollsBackAfterCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := &overrideServer{failAt: 1, onFail: cancel}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	scheduleClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	christmas := time.Date(2019, 12, 25, 0, 0, 0, 0, time.UTC)
+	_, err = scheduleClient.ApplyOverrides(ctx, []*CreateScheduleOverrideRequest{
+		overrideRequest(christmas, 24, ""),
+		overrideRequest(christmas.Add(24*time.Hour), 24, ""),
+	})
+	applyErr, ok := err.(*ApplyOverridesError)
+	assert.True(t, ok)
+	assert.Equal(t, 1, applyErr.Index)
+	assert.Empty(t, applyErr.RollbackErrors)
+	assert.Equal(t, []string{"override-0"}, server.deleted)
+}
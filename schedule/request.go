@@ -57,7 +57,7 @@ func (r *GetRequest) Validate() error {
 
 func (r *GetRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.IdentifierValue
+	return "/v2/schedules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetRequest) Method() string {
@@ -103,7 +103,7 @@ func (r *UpdateRequest) Validate() error {
 
 func (r *UpdateRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.IdentifierValue
+	return "/v2/schedules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -139,7 +139,7 @@ func (r *DeleteRequest) Validate() error {
 
 func (r *DeleteRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.IdentifierValue
+	return "/v2/schedules/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -213,7 +213,7 @@ func (r *GetTimelineRequest) Validate() error {
 
 func (r *GetTimelineRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.IdentifierValue + "/timeline"
+	return "/v2/schedules/" + client.PathSegment(r.IdentifierValue) + "/timeline"
 
 }
 
@@ -283,7 +283,7 @@ func (r *ExportScheduleRequest) getFileName() string {
 }
 
 func (r *ExportScheduleRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.getFileName()
+	return "/v2/schedules/" + client.PathSegment(r.getFileName())
 }
 
 func (r *ExportScheduleRequest) RequestParams() map[string]string {
@@ -358,7 +358,7 @@ func (r *CreateRotationRequest) Validate() error {
 }
 
 func (r *CreateRotationRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.ScheduleIdentifierValue + "/rotations"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifierValue) + "/rotations"
 
 }
 
@@ -401,7 +401,7 @@ func (r *GetRotationRequest) Validate() error {
 }
 
 func (r *GetRotationRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.ScheduleIdentifierValue + "/rotations/" + r.RotationId
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifierValue) + "/rotations/" + client.PathSegment(r.RotationId)
 
 }
 
@@ -445,7 +445,7 @@ func (r *UpdateRotationRequest) Validate() error {
 
 func (r *UpdateRotationRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifierValue + "/rotations/" + r.RotationId
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifierValue) + "/rotations/" + client.PathSegment(r.RotationId)
 
 }
 
@@ -489,7 +489,7 @@ func (r *DeleteRotationRequest) Validate() error {
 
 func (r *DeleteRotationRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifierValue + "/rotations/" + r.RotationId
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifierValue) + "/rotations/" + client.PathSegment(r.RotationId)
 
 }
 
@@ -528,7 +528,7 @@ func (r *ListRotationsRequest) Validate() error {
 
 func (r *ListRotationsRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifierValue + "/rotations"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifierValue) + "/rotations"
 
 }
 
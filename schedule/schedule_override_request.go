@@ -46,7 +46,7 @@ func (r *CreateScheduleOverrideRequest) Validate() error {
 
 func (r *CreateScheduleOverrideRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/overrides"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/overrides"
 }
 
 func (r *CreateScheduleOverrideRequest) Method() string {
@@ -87,7 +87,7 @@ func (r *GetScheduleOverrideRequest) Validate() error {
 
 func (r *GetScheduleOverrideRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/overrides/" + r.Alias
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/overrides/" + client.PathSegment(r.Alias)
 }
 
 func (r *GetScheduleOverrideRequest) Method() string {
@@ -122,7 +122,7 @@ func (r *ListScheduleOverrideRequest) Validate() error {
 }
 
 func (r *ListScheduleOverrideRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/overrides"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/overrides"
 }
 
 func (r *ListScheduleOverrideRequest) Method() string {
@@ -163,7 +163,7 @@ func (r *DeleteScheduleOverrideRequest) Validate() error {
 
 func (r *DeleteScheduleOverrideRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/overrides/" + r.Alias
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/overrides/" + client.PathSegment(r.Alias)
 }
 
 func (r *DeleteScheduleOverrideRequest) Method() string {
@@ -220,7 +220,7 @@ func (r *UpdateScheduleOverrideRequest) Validate() error {
 
 func (r *UpdateScheduleOverrideRequest) ResourcePath() string {
 
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/overrides/" + r.Alias
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/overrides/" + client.PathSegment(r.Alias)
 }
 
 func (r *UpdateScheduleOverrideRequest) Method() string {
@@ -28,7 +28,7 @@ func (r *GetOnCallsRequest) Method() string {
 }
 
 func (r *GetOnCallsRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/on-calls"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/on-calls"
 }
 
 func (r *GetOnCallsRequest) RequestParams() map[string]string {
@@ -72,7 +72,7 @@ func (r *GetNextOnCallsRequest) Method() string {
 }
 
 func (r *GetNextOnCallsRequest) ResourcePath() string {
-	return "/v2/schedules/" + r.ScheduleIdentifier + "/next-on-calls"
+	return "/v2/schedules/" + client.PathSegment(r.ScheduleIdentifier) + "/next-on-calls"
 }
 
 func (r *GetNextOnCallsRequest) RequestParams() map[string]string {
@@ -118,5 +118,5 @@ func (r *ExportOnCallUserRequest) getFileName() string {
 }
 
 func (r *ExportOnCallUserRequest) ResourcePath() string {
-	return "/v2/schedules/on-calls/" + r.getFileName()
+	return "/v2/schedules/on-calls/" + client.PathSegment(r.getFileName())
 }
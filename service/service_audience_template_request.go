@@ -22,7 +22,7 @@ func (r *GetAudienceTemplateRequest) Validate() error {
 }
 
 func (r *GetAudienceTemplateRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/audience-templates"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/audience-templates"
 }
 
 func (r *GetAudienceTemplateRequest) Method() string {
@@ -59,7 +59,7 @@ func (r *UpdateAudienceTemplateRequest) Validate() error {
 }
 
 func (r *UpdateAudienceTemplateRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/audience-templates"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/audience-templates"
 }
 
 func (r *UpdateAudienceTemplateRequest) Method() string {
@@ -37,7 +37,7 @@ func (r *CreateIncidentRuleRequest) Validate() error {
 }
 
 func (r *CreateIncidentRuleRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-rules"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-rules"
 }
 
 func (r *CreateIncidentRuleRequest) Method() string {
@@ -78,7 +78,7 @@ func (r *UpdateIncidentRuleRequest) Validate() error {
 }
 
 func (r *UpdateIncidentRuleRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-rules/" + r.IncidentRuleId
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-rules/" + client.PathSegment(r.IncidentRuleId)
 }
 
 func (r *UpdateIncidentRuleRequest) Method() string {
@@ -105,7 +105,7 @@ func (r *DeleteIncidentRuleRequest) Validate() error {
 }
 
 func (r *DeleteIncidentRuleRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-rules/" + r.IncidentRuleId
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-rules/" + client.PathSegment(r.IncidentRuleId)
 }
 
 func (r *DeleteIncidentRuleRequest) Method() string {
@@ -126,7 +126,7 @@ func (r *GetIncidentRulesRequest) Validate() error {
 }
 
 func (r *GetIncidentRulesRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-rules"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-rules"
 }
 
 func (r *GetIncidentRulesRequest) Method() string {
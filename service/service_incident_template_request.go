@@ -28,7 +28,7 @@ func (r *CreateIncidentTemplateRequest) Validate() error {
 }
 
 func (r *CreateIncidentTemplateRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-templates"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-templates"
 }
 
 func (r *CreateIncidentTemplateRequest) Method() string {
@@ -66,7 +66,7 @@ func (r *UpdateIncidentTemplateRequest) Validate() error {
 }
 
 func (r *UpdateIncidentTemplateRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-templates/" + r.IncidentTemplateId
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-templates/" + client.PathSegment(r.IncidentTemplateId)
 }
 
 func (r *UpdateIncidentTemplateRequest) Method() string {
@@ -93,7 +93,7 @@ func (r *DeleteIncidentTemplateRequest) Validate() error {
 }
 
 func (r *DeleteIncidentTemplateRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-templates/" + r.IncidentTemplateId
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-templates/" + client.PathSegment(r.IncidentTemplateId)
 }
 
 func (r *DeleteIncidentTemplateRequest) Method() string {
@@ -114,7 +114,7 @@ func (r *GetIncidentTemplatesRequest) Validate() error {
 }
 
 func (r *GetIncidentTemplatesRequest) ResourcePath() string {
-	return "/v1/services/" + r.ServiceId + "/incident-templates"
+	return "/v1/services/" + client.PathSegment(r.ServiceId) + "/incident-templates"
 }
 
 func (r *GetIncidentTemplatesRequest) Method() string {
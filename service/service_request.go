@@ -2,7 +2,6 @@ package service
 
 import (
 	"net/http"
-	"strconv"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -58,7 +57,7 @@ func (r *UpdateRequest) Validate() error {
 }
 
 func (r *UpdateRequest) ResourcePath() string {
-	return "/v1/services/" + r.Id
+	return "/v1/services/" + client.PathSegment(r.Id)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -78,7 +77,7 @@ func (r *DeleteRequest) Validate() error {
 }
 
 func (r *DeleteRequest) ResourcePath() string {
-	return "/v1/services/" + r.Id
+	return "/v1/services/" + client.PathSegment(r.Id)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -98,7 +97,7 @@ func (r *GetRequest) Validate() error {
 }
 
 func (r *GetRequest) ResourcePath() string {
-	return "/v1/services/" + r.Id
+	return "/v1/services/" + client.PathSegment(r.Id)
 }
 
 func (r *GetRequest) Method() string {
@@ -112,7 +111,11 @@ type ListRequest struct {
 }
 
 func (r *ListRequest) Validate() error {
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset}
 }
 
 func (r *ListRequest) ResourcePath() string {
@@ -125,12 +128,7 @@ func (r *ListRequest) Method() string {
 
 func (r *ListRequest) RequestParams() map[string]string {
 	params := map[string]string{}
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
+	r.listParams().AddTo(params)
 	return params
 }
 
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Store persists state of stateful helpers, such as queued requests, cursors and resume tokens. MemoryStore and
+// FileStore are provided; other backends like Redis or a database can be plugged in by implementing Store.
+type Store interface {
+	// Get returns the value of the key and false if the key does not exist.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Put(ctx context.Context, key string, value []byte) error
+	// List returns the keys starting with the given prefix in ascending order.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+type MemoryStore struct {
+	mux    sync.RWMutex
+	values map[string][]byte
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: make(map[string][]byte)}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	value, ok := s.values[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return append([]byte(nil), value...), true, nil
+}
+
+func (s *MemoryStore) Put(ctx context.Context, key string, value []byte) error {
+	if key == "" {
+		return errors.New("Key cannot be empty.")
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.values[key] = append([]byte(nil), value...)
+	return nil
+}
+
+func (s *MemoryStore) List(ctx context.Context, prefix string) ([]string, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	keys := make([]string, 0)
+	for key := range s.values {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// FileStore keeps each key in its own file in a directory. Values are written to a temporary file first and
+// renamed, so a crash never leaves a partially written value behind.
+type FileStore struct {
+	dir string
+	mux sync.Mutex
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("Directory cannot be empty.")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// fileName escapes the key so that it is a single, non-hidden file name. Dots are escaped as well so that keys like
+// ".." cannot point outside of the directory or clash with temporary files.
+func fileName(key string) string {
+	return strings.Replace(url.PathEscape(key), ".", "%2E", -1)
+}
+
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.dir, fileName(key))
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *FileStore) Put(ctx context.Context, key string, value []byte) error {
+	if key == "" {
+		return errors.New("Key cannot be empty.")
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	file, err := ioutil.TempFile(s.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(value)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), s.path(key))
+}
+
+func (s *FileStore) List(ctx context.Context, prefix string) ([]string, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0)
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+		key, err := url.PathUnescape(file.Name())
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
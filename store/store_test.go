@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testStore(t *testing.T, s Store) {
+	ctx := context.Background()
+
+	_, ok, err := s.Get(ctx, "queue/1")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Nil(t, s.Put(ctx, "queue/2", []byte("second")))
+	assert.Nil(t, s.Put(ctx, "queue/1", []byte("first")))
+	assert.Nil(t, s.Put(ctx, "cursor/alerts", []byte("42")))
+	assert.Nil(t, s.Put(ctx, "..", []byte("dots")))
+	assert.EqualError(t, s.Put(ctx, "", []byte("x")), "Key cannot be empty.")
+
+	value, ok, err := s.Get(ctx, "queue/1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "first", string(value))
+
+	assert.Nil(t, s.Put(ctx, "queue/1", []byte("updated")))
+	value, _, _ = s.Get(ctx, "queue/1")
+	assert.Equal(t, "updated", string(value))
+
+	keys, err := s.List(ctx, "queue/")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"queue/1", "queue/2"}, keys)
+
+	keys, err = s.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"..", "cursor/alerts", "queue/1", "queue/2"}, keys)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "store")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	s, err := NewFileStore(dir)
+	assert.Nil(t, err)
+	testStore(t, s)
+
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(files))
+
+	_, err = NewFileStore("")
+	assert.EqualError(t, err, "Directory cannot be empty.")
+}
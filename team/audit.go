@@ -0,0 +1,152 @@
+package team
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAuditPageSize = 100
+	teamLogsPageLimit    = 100
+)
+
+// AuditEntry is a team log entry together with the team it belongs to.
+type AuditEntry struct {
+	LogEntry
+	TeamId   string
+	TeamName string
+}
+
+// AuditReader merges the logs of all teams of the account into a single stream ordered by creation date and
+// serves it page by page. Logs are fetched lazily, a page of a team at a time when the stream reaches its end, so
+// logs created while reading may be picked up.
+type AuditReader struct {
+	client   *Client
+	PageSize int
+	// Order is "asc" (oldest first, default) or "desc".
+	Order string
+
+	teams   []*teamLogs
+	loaded  bool
+	pending []AuditEntry
+}
+
+// teamLogs holds the fetched logs of a team that are not served yet.
+type teamLogs struct {
+	team    ListedTeams
+	entries []AuditEntry
+	cursor  string
+	done    bool
+}
+
+func NewAuditReader(client *Client) *AuditReader {
+	return &AuditReader{
+		client:   client,
+		PageSize: defaultAuditPageSize,
+	}
+}
+
+// Next returns the next page of entries. An empty page means the stream is exhausted.
+func (r *AuditReader) Next(ctx context.Context) ([]AuditEntry, error) {
+	if r.Order != "" && r.Order != "asc" && r.Order != "desc" {
+		return nil, errors.New("Order should be one of these: 'asc', 'desc'")
+	}
+	if !r.loaded {
+		teams, err := r.client.List(ctx, &ListTeamRequest{})
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not list teams")
+		}
+		for _, team := range teams.Teams {
+			r.teams = append(r.teams, &teamLogs{team: team})
+		}
+		r.loaded = true
+	}
+
+	pageSize := r.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultAuditPageSize
+	}
+	// Entries taken before a failed fetch are kept for the next call.
+	page := r.pending
+	r.pending = nil
+	for len(page) < pageSize {
+		next, err := r.nextTeam(ctx)
+		if err != nil {
+			r.pending = page
+			return nil, err
+		}
+		if next == nil {
+			break
+		}
+		page = append(page, next.entries[0])
+		next.entries = next.entries[1:]
+	}
+	return page, nil
+}
+
+// nextTeam returns the team whose next entry comes first in the stream, or nil when all logs are served. Teams
+// without fetched entries fetch their next page first.
+func (r *AuditReader) nextTeam(ctx context.Context) (*teamLogs, error) {
+	var next *teamLogs
+	for _, logs := range r.teams {
+		if len(logs.entries) == 0 && !logs.done {
+			if err := r.fetch(ctx, logs); err != nil {
+				return nil, err
+			}
+		}
+		if len(logs.entries) == 0 {
+			continue
+		}
+		if next == nil || r.before(logs.entries[0], next.entries[0]) {
+			next = logs
+		}
+	}
+	return next, nil
+}
+
+func (r *AuditReader) fetch(ctx context.Context, logs *teamLogs) error {
+	order := r.Order
+	if order == "" {
+		order = "asc"
+	}
+	result, err := r.client.ListTeamLogs(ctx, &ListTeamLogsRequest{
+		IdentifierType:  Id,
+		IdentifierValue: logs.team.Id,
+		Limit:           teamLogsPageLimit,
+		Order:           order,
+		Cursor:          logs.cursor,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Could not list logs of team %s", logs.team.Name)
+	}
+	for _, log := range result.Logs {
+		logs.entries = append(logs.entries, AuditEntry{LogEntry: log, TeamId: logs.team.Id, TeamName: logs.team.Name})
+	}
+	sort.SliceStable(logs.entries, func(i, j int) bool {
+		return r.before(logs.entries[i], logs.entries[j])
+	})
+	logs.done = len(result.Logs) < teamLogsPageLimit || result.Offset == "" || result.Offset == logs.cursor
+	logs.cursor = result.Offset
+	return nil
+}
+
+// before reports whether entry a comes before entry b in the order of the reader.
+func (r *AuditReader) before(a, b AuditEntry) bool {
+	if r.Order == "desc" {
+		return createdBefore(b.CreatedDate, a.CreatedDate)
+	}
+	return createdBefore(a.CreatedDate, b.CreatedDate)
+}
+
+// createdBefore compares RFC3339 creation dates and falls back to comparing the raw values when one does not parse.
+func createdBefore(a, b string) bool {
+	timeA, errA := time.Parse(time.RFC3339Nano, a)
+	timeB, errB := time.Parse(time.RFC3339Nano, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return timeA.Before(timeB)
+}
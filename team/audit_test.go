@@ -0,0 +1,111 @@
+package team
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditReader(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/teams":
+			fmt.Fprint(w, `{"data": [{"id": "t1", "name": "ops"}, {"id": "t2", "name": "dev"}], "took": 0.1, "requestId": "rId"}`)
+		case "/v2/teams/t1/logs":
+			fmt.Fprint(w, `{"data": {"offset": "2", "logs": [
+				{"log": "ops created", "owner": "a", "createdDate": "2019-12-24T10:00:00Z"},
+				{"log": "ops renamed", "owner": "a", "createdDate": "2019-12-24T12:00:00Z"}]}, "took": 0.1, "requestId": "rId"}`)
+		case "/v2/teams/t2/logs":
+			fmt.Fprint(w, `{"data": {"offset": "1", "logs": [
+				{"log": "dev created", "owner": "b", "createdDate": "2019-12-24T11:00:00Z"}]}, "took": 0.1, "requestId": "rId"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	teamClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	reader := NewAuditReader(teamClient)
+	reader.PageSize = 2
+	page, err := reader.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(page))
+	assert.Equal(t, "ops created", page[0].Log)
+	assert.Equal(t, "dev created", page[1].Log)
+	assert.Equal(t, "dev", page[1].TeamName)
+
+	page, err = reader.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(page))
+	assert.Equal(t, "ops renamed", page[0].Log)
+
+	page, err = reader.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Empty(t, page)
+
+	reader = NewAuditReader(teamClient)
+	reader.Order = "desc"
+	page, err = reader.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "ops renamed", page[0].Log)
+	assert.Equal(t, "ops created", page[2].Log)
+}
+
+func TestAuditReaderFetchesPagesLazily(t *testing.T) {
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/teams":
+			fmt.Fprint(w, `{"data": [{"id": "t1", "name": "ops"}], "took": 0.1, "requestId": "rId"}`)
+		case "/v2/teams/t1/logs":
+			offset := r.URL.Query().Get("offset")
+			requests = append(requests, offset)
+			if offset == "" {
+				logs := make([]string, teamLogsPageLimit)
+				for i := range logs {
+					logs[i] = fmt.Sprintf(`{"log": "log %d", "createdDate": "2019-12-24T10:%02d:00Z"}`, i, i%60)
+				}
+				fmt.Fprintf(w, `{"data": {"offset": "1577181600000_100", "logs": [%s]}, "took": 0.1, "requestId": "rId"}`, strings.Join(logs, ","))
+				return
+			}
+			fmt.Fprint(w, `{"data": {"offset": "1577185200000_101", "logs": [
+				{"log": "last", "createdDate": "2019-12-24T11:00:00Z"}]}, "took": 0.1, "requestId": "rId"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	teamClient, err := NewClient(&client.Config{
+		ApiKey:         "apiKey",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	assert.Nil(t, err)
+
+	reader := NewAuditReader(teamClient)
+	reader.PageSize = 10
+	page, err := reader.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(page))
+	assert.Equal(t, []string{""}, requests)
+
+	count := len(page)
+	for len(page) > 0 {
+		page, err = reader.Next(context.Background())
+		assert.Nil(t, err)
+		count += len(page)
+	}
+	assert.Equal(t, teamLogsPageLimit+1, count)
+	assert.Equal(t, []string{"", "1577181600000_100"}, requests)
+}
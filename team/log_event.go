@@ -0,0 +1,87 @@
+package team
+
+import (
+	"regexp"
+	"strings"
+)
+
+// LogAction is the kind of change a team log describes.
+type LogAction string
+
+const (
+	LogCreate            LogAction = "create"
+	LogRename            LogAction = "rename"
+	LogUpdateDescription LogAction = "update-description"
+	LogAddMember         LogAction = "add-member"
+	LogRemoveMember      LogAction = "remove-member"
+	LogUpdateMemberRole  LogAction = "update-member-role"
+	LogAddRole           LogAction = "add-role"
+	LogRemoveRole        LogAction = "remove-role"
+	LogAddRoutingRule    LogAction = "add-routing-rule"
+	LogRemoveRoutingRule LogAction = "remove-routing-rule"
+)
+
+// LogEvent is a team log parsed into who changed what. Fields the log doesn't mention are left empty; Actor falls
+// back to the owner of the log.
+type LogEvent struct {
+	Action   LogAction
+	Actor    string
+	Target   string
+	OldValue string
+	NewValue string
+}
+
+type logPattern struct {
+	action LogAction
+	regexp *regexp.Regexp
+}
+
+// logBy is the optional actor suffix most team logs end with.
+const logBy = `(?: by \[?(?P<actor>[^\]]+?)\]?)?`
+
+func newLogPattern(action LogAction, expr string) logPattern {
+	return logPattern{action: action, regexp: regexp.MustCompile(`(?i)^` + expr + `\.?$`)}
+}
+
+var teamLogPatterns = []logPattern{
+	newLogPattern(LogCreate, `Team \[?(?P<target>[^\]]+?)\]? (?:is |was )?created`+logBy),
+	newLogPattern(LogRename, `Team \[?(?P<old>[^\]]+?)\]? (?:is |was )?renamed to \[?(?P<new>[^\]]+?)\]?`+logBy),
+	newLogPattern(LogUpdateDescription, `(?:Team )?description (?:is |was )?(?:changed|updated)(?: from \[(?P<old>.*)\] to \[(?P<new>.*)\])?`+logBy),
+	newLogPattern(LogUpdateMemberRole, `Role of (?:user )?\[?(?P<target>[^\]]+?)\]? (?:is |was )?(?:changed|updated) from \[?(?P<old>[^\]]+?)\]? to \[?(?P<new>[^\]]+?)\]?`+logBy),
+	newLogPattern(LogAddMember, `(?:User )?\[?(?P<target>[^\]]+?)\]? (?:is |was )?added to (?:the )?team(?: \[[^\]]+\])?(?: (?:with|as) role \[?(?P<new>[^\]]+?)\]?)?`+logBy),
+	newLogPattern(LogRemoveMember, `(?:User )?\[?(?P<target>[^\]]+?)\]? (?:is |was )?removed from (?:the )?team(?: \[[^\]]+\])?`+logBy),
+	newLogPattern(LogAddRole, `(?:Team )?role \[?(?P<target>[^\]]+?)\]? (?:is |was )?(?:added|created)`+logBy),
+	newLogPattern(LogRemoveRole, `(?:Team )?role \[?(?P<target>[^\]]+?)\]? (?:is |was )?(?:removed|deleted)`+logBy),
+	newLogPattern(LogAddRoutingRule, `(?:Team )?routing rule \[?(?P<target>[^\]]+?)\]? (?:is |was )?(?:added|created)`+logBy),
+	newLogPattern(LogRemoveRoutingRule, `(?:Team )?routing rule \[?(?P<target>[^\]]+?)\]? (?:is |was )?(?:removed|deleted)`+logBy),
+}
+
+// ParseLog parses the log with the known Opsgenie team log formats. The formats are not documented by Opsgenie and
+// may change, so false is returned for logs that match none of them; use Log as is then.
+func (e LogEntry) ParseLog() (LogEvent, bool) {
+	log := strings.TrimSpace(e.Log)
+	for _, pattern := range teamLogPatterns {
+		match := pattern.regexp.FindStringSubmatch(log)
+		if match == nil {
+			continue
+		}
+		event := LogEvent{Action: pattern.action, Actor: e.Owner}
+		for i, name := range pattern.regexp.SubexpNames() {
+			if match[i] == "" {
+				continue
+			}
+			switch name {
+			case "actor":
+				event.Actor = match[i]
+			case "target":
+				event.Target = match[i]
+			case "old":
+				event.OldValue = match[i]
+			case "new":
+				event.NewValue = match[i]
+			}
+		}
+		return event, true
+	}
+	return LogEvent{}, false
+}
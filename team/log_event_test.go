@@ -0,0 +1,29 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogEntryParseLog(t *testing.T) {
+	tests := []struct {
+		log   string
+		event LogEvent
+	}{
+		{"Team [ops] is created by [john@example.com].", LogEvent{Action: LogCreate, Actor: "john@example.com", Target: "ops"}},
+		{"Team [ops] renamed to [sre]", LogEvent{Action: LogRename, Actor: "owner@example.com", OldValue: "ops", NewValue: "sre"}},
+		{"User [jane@example.com] is added to team [ops] with role [admin] by [john@example.com].", LogEvent{Action: LogAddMember, Actor: "john@example.com", Target: "jane@example.com", NewValue: "admin"}},
+		{"[jane@example.com] removed from team", LogEvent{Action: LogRemoveMember, Actor: "owner@example.com", Target: "jane@example.com"}},
+		{"Role of [jane@example.com] changed from [user] to [admin]", LogEvent{Action: LogUpdateMemberRole, Actor: "owner@example.com", Target: "jane@example.com", OldValue: "user", NewValue: "admin"}},
+		{"Routing rule [business hours] is added", LogEvent{Action: LogAddRoutingRule, Actor: "owner@example.com", Target: "business hours"}},
+	}
+	for _, test := range tests {
+		event, ok := LogEntry{Log: test.log, Owner: "owner@example.com"}.ParseLog()
+		assert.True(t, ok, test.log)
+		assert.Equal(t, test.event, event, test.log)
+	}
+
+	_, ok := LogEntry{Log: "Something unexpected happened"}.ParseLog()
+	assert.False(t, ok)
+}
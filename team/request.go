@@ -3,7 +3,6 @@ package team
 import (
 	"errors"
 	"net/http"
-	"strconv"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/og"
@@ -79,7 +78,7 @@ func (r *DeleteTeamRequest) Validate() error {
 
 func (r *DeleteTeamRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.IdentifierValue
+	return "/v2/teams/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *DeleteTeamRequest) Method() string {
@@ -115,7 +114,7 @@ func (r *GetTeamRequest) Validate() error {
 
 func (r *GetTeamRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.IdentifierValue
+	return "/v2/teams/" + client.PathSegment(r.IdentifierValue)
 }
 
 func (r *GetTeamRequest) Method() string {
@@ -152,7 +151,7 @@ func (r *UpdateTeamRequest) Validate() error {
 
 func (r *UpdateTeamRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.Id
+	return "/v2/teams/" + client.PathSegment(r.Id)
 }
 
 func (r *UpdateTeamRequest) Method() string {
@@ -166,6 +165,8 @@ type ListTeamLogsRequest struct {
 	Limit           int    `json:"limit,omitempty"`
 	Order           string `json:"order,omitempty"`
 	Offset          int    `json:"offset,omitempty"`
+	// Cursor is the Offset of the previous ListTeamLogsResult. When set it is sent instead of Offset.
+	Cursor string `json:"-"`
 }
 
 func (r *ListTeamLogsRequest) Validate() error {
@@ -174,12 +175,16 @@ func (r *ListTeamLogsRequest) Validate() error {
 		return err
 	}
 
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListTeamLogsRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Order: r.Order}
 }
 
 func (r *ListTeamLogsRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.IdentifierValue + "/logs"
+	return "/v2/teams/" + client.PathSegment(r.IdentifierValue) + "/logs"
 
 }
 
@@ -197,14 +202,9 @@ func (r *ListTeamLogsRequest) RequestParams() map[string]string {
 		params["identifierType"] = "id"
 	}
 
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
-	if r.Order != "" {
-		params["order"] = string(r.Order)
+	r.listParams().AddTo(params)
+	if r.Cursor != "" {
+		params["offset"] = r.Cursor
 	}
 
 	return params
@@ -243,7 +243,7 @@ func (r *CreateTeamRoleRequest) Validate() error {
 
 func (r *CreateTeamRoleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/roles"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/roles"
 
 }
 
@@ -289,15 +289,15 @@ func (r *GetTeamRoleRequest) ResourcePath() string {
 
 	if r.TeamName != "" {
 		if r.RoleName != "" {
-			return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleName
+			return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleName)
 		}
-		return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleID
+		return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleID)
 	}
 
 	if r.RoleName != "" {
-		return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleName
+		return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleName)
 	}
-	return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleID
+	return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleID)
 
 }
 
@@ -351,15 +351,15 @@ func (r *UpdateTeamRoleRequest) ResourcePath() string {
 
 	if r.TeamName != "" {
 		if r.RoleName != "" {
-			return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleName
+			return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleName)
 		}
-		return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleID
+		return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleID)
 	}
 
 	if r.RoleName != "" {
-		return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleName
+		return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleName)
 	}
-	return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleID
+	return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleID)
 
 }
 
@@ -409,15 +409,15 @@ func (r *DeleteTeamRoleRequest) Validate() error {
 func (r *DeleteTeamRoleRequest) ResourcePath() string {
 	if r.TeamName != "" {
 		if r.RoleName != "" {
-			return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleName
+			return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleName)
 		}
-		return "/v2/teams/" + r.TeamName + "/roles/" + r.RoleID
+		return "/v2/teams/" + client.PathSegment(r.TeamName) + "/roles/" + client.PathSegment(r.RoleID)
 	}
 
 	if r.RoleName != "" {
-		return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleName
+		return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleName)
 	}
-	return "/v2/teams/" + r.TeamID + "/roles/" + r.RoleID
+	return "/v2/teams/" + client.PathSegment(r.TeamID) + "/roles/" + client.PathSegment(r.RoleID)
 
 }
 
@@ -460,7 +460,7 @@ func (r *ListTeamRoleRequest) Validate() error {
 
 func (r *ListTeamRoleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/roles"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/roles"
 }
 
 func (r *ListTeamRoleRequest) Method() string {
@@ -517,7 +517,7 @@ func (r *AddTeamMemberRequest) Validate() error {
 
 func (r *AddTeamMemberRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/members"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/members"
 
 }
 
@@ -565,7 +565,7 @@ func (r *RemoveTeamMemberRequest) Validate() error {
 
 func (r *RemoveTeamMemberRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/members/" + r.MemberIdentifierValue
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/members/" + client.PathSegment(r.MemberIdentifierValue)
 
 }
 
@@ -646,7 +646,7 @@ func (r *CreateRoutingRuleRequest) Validate() error {
 
 func (r *CreateRoutingRuleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules"
 
 }
 
@@ -694,7 +694,7 @@ func (r *GetRoutingRuleRequest) Validate() error {
 
 func (r *GetRoutingRuleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules/" + r.RoutingRuleId
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules/" + client.PathSegment(r.RoutingRuleId)
 
 }
 
@@ -763,7 +763,7 @@ func (r *UpdateRoutingRuleRequest) Validate() error {
 
 func (r *UpdateRoutingRuleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules/" + r.RoutingRuleId
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules/" + client.PathSegment(r.RoutingRuleId)
 
 }
 
@@ -806,7 +806,7 @@ func (r *DeleteRoutingRuleRequest) Validate() error {
 
 func (r *DeleteRoutingRuleRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules/" + r.RoutingRuleId
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules/" + client.PathSegment(r.RoutingRuleId)
 
 }
 
@@ -844,7 +844,7 @@ func (r *ListRoutingRulesRequest) Validate() error {
 
 func (r *ListRoutingRulesRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules"
 
 }
 
@@ -892,7 +892,7 @@ func (r *ChangeRoutingRuleOrderRequest) Validate() error {
 
 func (r *ChangeRoutingRuleOrderRequest) ResourcePath() string {
 
-	return "/v2/teams/" + r.TeamIdentifierValue + "/routing-rules/" + r.RoutingRuleId + "/change-order"
+	return "/v2/teams/" + client.PathSegment(r.TeamIdentifierValue) + "/routing-rules/" + client.PathSegment(r.RoutingRuleId) + "/change-order"
 
 }
 
@@ -0,0 +1,132 @@
+package ticket
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/incident"
+)
+
+// Ticket is a flat representation of an alert or incident that maps directly to issue fields of ticket systems
+// such as Jira or ServiceNow.
+type Ticket struct {
+	Summary      string
+	Description  string
+	Priority     string
+	Labels       []string
+	CustomFields map[string]string
+}
+
+// Mapping configures the conversion. CustomFields maps ticket field names to source fields of the alert or incident:
+// id, tinyId, alias, status, source, owner, entity, priority, createdAt, updatedAt, or details.<key> for alert
+// details and incident extra properties. Priorities maps OpsGenie priorities to the priorities of the ticket system,
+// unmapped priorities are kept as they are.
+type Mapping struct {
+	CustomFields     map[string]string
+	Priorities       map[string]string
+	MaxSummaryLength int
+}
+
+// DefaultMapping keeps summaries within the Jira limit and carries the OpsGenie id and tiny id over.
+var DefaultMapping = Mapping{
+	CustomFields:     map[string]string{"opsgenie_id": "id", "opsgenie_tiny_id": "tinyId"},
+	MaxSummaryLength: 255,
+}
+
+func FromAlert(a *alert.GetAlertResult, mapping *Mapping) *Ticket {
+	fields := map[string]string{
+		"id":        a.Id,
+		"tinyId":    a.TinyId,
+		"alias":     a.Alias,
+		"status":    a.Status,
+		"source":    a.Source,
+		"owner":     a.Owner,
+		"entity":    a.Entity,
+		"priority":  string(a.Priority),
+		"createdAt": formatTime(a.CreatedAt),
+		"updatedAt": formatTime(a.UpdatedAt),
+	}
+	return build(a.Message, a.Description, string(a.Priority), a.Tags, a.Details, fields, mapping)
+}
+
+func FromIncident(i *incident.Incident, mapping *Mapping) *Ticket {
+	fields := map[string]string{
+		"id":        i.Id,
+		"tinyId":    i.TinyId,
+		"status":    i.Status,
+		"owner":     i.OwnerTeam,
+		"priority":  string(i.Priority),
+		"createdAt": formatTime(i.CreatedAt),
+		"updatedAt": formatTime(i.UpdatedAt),
+	}
+	return build(i.Message, "", string(i.Priority), i.Tags, i.ExtraProperties, fields, mapping)
+}
+
+func build(message string, description string, priority string, tags []string, details map[string]string, fields map[string]string, mapping *Mapping) *Ticket {
+	if mapping == nil {
+		mapping = &DefaultMapping
+	}
+	ticket := &Ticket{
+		Summary:      message,
+		Description:  buildDescription(description, details),
+		Priority:     priority,
+		Labels:       make([]string, 0, len(tags)),
+		CustomFields: make(map[string]string),
+	}
+	if mapped, ok := mapping.Priorities[priority]; ok {
+		ticket.Priority = mapped
+	}
+	if mapping.MaxSummaryLength > 0 && len([]rune(ticket.Summary)) > mapping.MaxSummaryLength {
+		ticket.Summary = string([]rune(ticket.Summary)[:mapping.MaxSummaryLength])
+	}
+	for _, tag := range tags {
+		ticket.Labels = append(ticket.Labels, label(tag))
+	}
+	for name, source := range mapping.CustomFields {
+		var value string
+		if strings.HasPrefix(source, "details.") {
+			value = details[strings.TrimPrefix(source, "details.")]
+		} else {
+			value = fields[source]
+		}
+		if value != "" {
+			ticket.CustomFields[name] = value
+		}
+	}
+	return ticket
+}
+
+func buildDescription(description string, details map[string]string) string {
+	if len(details) == 0 {
+		return description
+	}
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	if description != "" {
+		builder.WriteString(description)
+		builder.WriteString("\n\n")
+	}
+	for _, key := range keys {
+		builder.WriteString(key + ": " + details[key] + "\n")
+	}
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// label replaces whitespace, which most ticket systems do not allow in labels, with underscores.
+func label(tag string) string {
+	return strings.Join(strings.Fields(tag), "_")
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,54 @@
+package ticket
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/incident"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromAlert(t *testing.T) {
+	a := &alert.GetAlertResult{
+		Id:          "a1",
+		TinyId:      "42",
+		Message:     strings.Repeat("x", 300),
+		Description: "Database is down",
+		Priority:    alert.P1,
+		Tags:        []string{"db", "needs review"},
+		Details:     map[string]string{"region": "eu", "host": "db-1"},
+		CreatedAt:   time.Date(2019, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	ticket := FromAlert(a, nil)
+	assert.Equal(t, 255, len(ticket.Summary))
+	assert.Equal(t, "Database is down\n\nhost: db-1\nregion: eu", ticket.Description)
+	assert.Equal(t, "P1", ticket.Priority)
+	assert.Equal(t, []string{"db", "needs_review"}, ticket.Labels)
+	assert.Equal(t, map[string]string{"opsgenie_id": "a1", "opsgenie_tiny_id": "42"}, ticket.CustomFields)
+
+	ticket = FromAlert(a, &Mapping{
+		CustomFields: map[string]string{"customfield_10001": "details.region", "customfield_10002": "createdAt", "empty": "alias"},
+		Priorities:   map[string]string{"P1": "Highest"},
+	})
+	assert.Equal(t, 300, len(ticket.Summary))
+	assert.Equal(t, "Highest", ticket.Priority)
+	assert.Equal(t, map[string]string{"customfield_10001": "eu", "customfield_10002": "2019-05-01T10:00:00Z"}, ticket.CustomFields)
+}
+
+func TestFromIncident(t *testing.T) {
+	ticket := FromIncident(&incident.Incident{
+		Id:              "i1",
+		Message:         "Checkout is failing",
+		Priority:        incident.P2,
+		OwnerTeam:       "t1",
+		ExtraProperties: map[string]string{"service": "checkout"},
+	}, &Mapping{CustomFields: map[string]string{"team": "owner", "service": "details.service"}})
+	assert.Equal(t, "Checkout is failing", ticket.Summary)
+	assert.Equal(t, "service: checkout", ticket.Description)
+	assert.Equal(t, "P2", ticket.Priority)
+	assert.Empty(t, ticket.Labels)
+	assert.Equal(t, map[string]string{"team": "t1", "service": "checkout"}, ticket.CustomFields)
+}
@@ -0,0 +1,93 @@
+package user
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Locale is a language tag in the language_COUNTRY form OpsGenie uses, such as en_US.
+type Locale string
+
+const (
+	EnglishUS Locale = "en_US"
+	EnglishGB Locale = "en_GB"
+	German    Locale = "de_DE"
+	French    Locale = "fr_FR"
+	Spanish   Locale = "es_ES"
+	Turkish   Locale = "tr_TR"
+	Japanese  Locale = "ja_JP"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2}(_[A-Z]{2})?$`)
+
+func (l Locale) Validate() error {
+	if !localePattern.MatchString(string(l)) {
+		return errors.New("Locale " + string(l) + " should be in language_COUNTRY format such as en_US")
+	}
+	return nil
+}
+
+func validateTimeZone(location *time.Location) error {
+	if location == nil {
+		return nil
+	}
+	if location.String() == "Local" {
+		return errors.New("TimeZone should be a named location such as Europe/London, not Local")
+	}
+	if _, err := time.LoadLocation(location.String()); err != nil {
+		return errors.New("TimeZone " + location.String() + " is not a valid IANA time zone")
+	}
+	return nil
+}
+
+func validateTimeZoneAndLocale(location *time.Location, locale Locale) error {
+	if err := validateTimeZone(location); err != nil {
+		return err
+	}
+	if locale != "" {
+		return locale.Validate()
+	}
+	return nil
+}
+
+func timeZoneName(location *time.Location) string {
+	if location == nil {
+		return ""
+	}
+	return location.String()
+}
+
+func (r *CreateRequest) MarshalJSON() ([]byte, error) {
+	type createRequest CreateRequest
+	return json.Marshal(&struct {
+		*createRequest
+		TimeZone string `json:"timeZone,omitempty"`
+	}{
+		createRequest: (*createRequest)(r),
+		TimeZone:      timeZoneName(r.TimeZone),
+	})
+}
+
+func (r *UpdateRequest) MarshalJSON() ([]byte, error) {
+	type updateRequest UpdateRequest
+	return json.Marshal(&struct {
+		*updateRequest
+		TimeZone string `json:"timeZone,omitempty"`
+	}{
+		updateRequest: (*updateRequest)(r),
+		TimeZone:      timeZoneName(r.TimeZone),
+	})
+}
+
+// Location parses the time zone of the user.
+func (u *User) Location() (*time.Location, error) {
+	return time.LoadLocation(u.TimeZone)
+}
+
+// Location parses the time zone of the user.
+func (r *GetResult) Location() (*time.Location, error) {
+	return time.LoadLocation(r.TimeZone)
+}
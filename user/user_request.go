@@ -2,7 +2,7 @@ package user
 
 import (
 	"net/http"
-	"strconv"
+	"time"
 
 	"github.com/joeyparsons/opsgenie-go-sdk-v2/client"
 	"github.com/pkg/errors"
@@ -49,8 +49,8 @@ type CreateRequest struct {
 	UserAddressRequest *UserAddressRequest `json:"userAddress,omitempty"`
 	Tags               []string            `json:"tags,omitempty"`
 	Details            map[string][]string `json:"details,omitempty"`
-	TimeZone           string              `json:"timeZone,omitempty"`
-	Locale             string              `json:"locale,omitempty"`
+	TimeZone           *time.Location      `json:"-"`
+	Locale             Locale              `json:"locale,omitempty"`
 	InvitationDisabled string              `json:"invitationDisabled,omitempty"`
 }
 
@@ -65,7 +65,7 @@ func (r *CreateRequest) Validate() error {
 		return errors.New("User Role can not be empty")
 	}
 
-	return nil
+	return validateTimeZoneAndLocale(r.TimeZone, r.Locale)
 }
 
 func (r *CreateRequest) ResourcePath() string {
@@ -92,7 +92,7 @@ func (r *GetRequest) Validate() error {
 
 func (r *GetRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier
+	return "/v2/users/" + client.PathSegment(r.Identifier)
 }
 
 func (r *GetRequest) Method() string {
@@ -119,8 +119,8 @@ type UpdateRequest struct {
 	UserAddressRequest *UserAddressRequest `json:"userAddress,omitempty"`
 	Tags               []string            `json:"tags,omitempty"`
 	Details            map[string][]string `json:"details,omitempty"`
-	TimeZone           string              `json:"timeZone,omitempty"`
-	Locale             string              `json:"locale,omitempty"`
+	TimeZone           *time.Location      `json:"-"`
+	Locale             Locale              `json:"locale,omitempty"`
 	InvitationDisabled string              `json:"invitationDisabled,omitempty"`
 }
 
@@ -132,12 +132,12 @@ func (r *UpdateRequest) Validate() error {
 		return errors.New("User role name can not be empty")
 	}
 
-	return nil
+	return validateTimeZoneAndLocale(r.TimeZone, r.Locale)
 }
 
 func (r *UpdateRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier
+	return "/v2/users/" + client.PathSegment(r.Identifier)
 }
 
 func (r *UpdateRequest) Method() string {
@@ -158,7 +158,7 @@ func (r *DeleteRequest) Validate() error {
 
 func (r *DeleteRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier
+	return "/v2/users/" + client.PathSegment(r.Identifier)
 }
 
 func (r *DeleteRequest) Method() string {
@@ -175,7 +175,11 @@ type ListRequest struct {
 }
 
 func (r *ListRequest) Validate() error {
-	return nil
+	return r.listParams().Validate()
+}
+
+func (r *ListRequest) listParams() client.ListParams {
+	return client.ListParams{Limit: r.Limit, Offset: r.Offset, Sort: string(r.Sort), Order: string(r.Order), Query: r.Query}
 }
 
 func (r *ListRequest) ResourcePath() string {
@@ -190,26 +194,7 @@ func (r *ListRequest) Method() string {
 func (r *ListRequest) RequestParams() map[string]string {
 
 	params := make(map[string]string)
-
-	if r.Limit != 0 {
-		params["limit"] = strconv.Itoa(r.Limit)
-	}
-
-	if r.Sort != "" {
-		params["sort"] = string(r.Sort)
-	}
-
-	if r.Offset != 0 {
-		params["offset"] = strconv.Itoa(r.Offset)
-	}
-
-	if r.Query != "" {
-		params["query"] = r.Query
-	}
-
-	if r.Order != "" {
-		params["order"] = string(r.Order)
-	}
+	r.listParams().AddTo(params)
 
 	return params
 }
@@ -228,7 +213,7 @@ func (r *ListUserEscalationsRequest) Validate() error {
 
 func (r *ListUserEscalationsRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier + "/escalations"
+	return "/v2/users/" + client.PathSegment(r.Identifier) + "/escalations"
 }
 
 func (r *ListUserEscalationsRequest) Method() string {
@@ -249,7 +234,7 @@ func (r *ListUserTeamsRequest) Validate() error {
 
 func (r *ListUserTeamsRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier + "/teams"
+	return "/v2/users/" + client.PathSegment(r.Identifier) + "/teams"
 }
 
 func (r *ListUserTeamsRequest) Method() string {
@@ -270,7 +255,7 @@ func (r *ListUserForwardingRulesRequest) Validate() error {
 
 func (r *ListUserForwardingRulesRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier + "/forwarding-rules"
+	return "/v2/users/" + client.PathSegment(r.Identifier) + "/forwarding-rules"
 }
 
 func (r *ListUserForwardingRulesRequest) Method() string {
@@ -291,7 +276,7 @@ func (r *ListUserSchedulesRequest) Validate() error {
 
 func (r *ListUserSchedulesRequest) ResourcePath() string {
 
-	return "/v2/users/" + r.Identifier + "/schedules"
+	return "/v2/users/" + client.PathSegment(r.Identifier) + "/schedules"
 }
 
 func (r *ListUserSchedulesRequest) Method() string {
@@ -313,7 +298,7 @@ func (r *GetSavedSearchRequest) Validate() error {
 
 func (r *GetSavedSearchRequest) ResourcePath() string {
 
-	return "/v2/users/saved-searches/" + r.Identifier
+	return "/v2/users/saved-searches/" + client.PathSegment(r.Identifier)
 }
 
 func (r *GetSavedSearchRequest) Method() string {
@@ -364,7 +349,7 @@ func (r *DeleteSavedSearchRequest) Validate() error {
 
 func (r *DeleteSavedSearchRequest) ResourcePath() string {
 
-	return "/v2/users/saved-searches/" + r.Identifier
+	return "/v2/users/saved-searches/" + client.PathSegment(r.Identifier)
 }
 
 func (r *DeleteSavedSearchRequest) Method() string {
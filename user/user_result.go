@@ -17,7 +17,7 @@ type User struct {
 	Tags        []string            `json:"tags"`
 	Details     map[string][]string `json:"details"`
 	TimeZone    string              `json:"timeZone"`
-	Locale      string              `json:"locale"`
+	Locale      Locale              `json:"locale"`
 	CreatedAt   time.Time           `json:"createdAt"`
 }
 
@@ -61,7 +61,7 @@ type GetResult struct {
 	Tags          []string            `json:"tags"`
 	Details       map[string][]string `json:"details"`
 	TimeZone      string              `json:"timeZone"`
-	Locale        string              `json:"locale"`
+	Locale        Locale              `json:"locale"`
 	CreatedAt     time.Time           `json:"createdAt"`
 	UserContacts  []UserContact       `json:"userContacts"`
 }
@@ -1,9 +1,11 @@
 package user
 
 import (
+	"encoding/json"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestCreateUserRequest_Validate(t *testing.T) {
@@ -238,3 +240,40 @@ func TestDeleteUsersSavedSearchRequest_Validate(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, reqParam["identifierType"], "name")
 }
+
+func TestTimeZoneAndLocale(t *testing.T) {
+	istanbul, err := time.LoadLocation("Europe/Istanbul")
+	assert.Nil(t, err)
+
+	createRequest := &CreateRequest{
+		Username: "user@example.com",
+		FullName: "user",
+		Role:     &UserRoleRequest{RoleName: "Admin"},
+		TimeZone: istanbul,
+		Locale:   Turkish,
+	}
+	assert.Nil(t, createRequest.Validate())
+	payload, err := json.Marshal(createRequest)
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), `"timeZone":"Europe/Istanbul"`)
+	assert.Contains(t, string(payload), `"locale":"tr_TR"`)
+
+	createRequest.Locale = "turkish"
+	assert.EqualError(t, createRequest.Validate(), "Locale turkish should be in language_COUNTRY format such as en_US")
+
+	updateRequest := &UpdateRequest{Identifier: "123", TimeZone: time.Local}
+	assert.EqualError(t, updateRequest.Validate(), "TimeZone should be a named location such as Europe/London, not Local")
+
+	updateRequest.TimeZone = time.FixedZone("Europe/Nowhere", 3600)
+	assert.EqualError(t, updateRequest.Validate(), "TimeZone Europe/Nowhere is not a valid IANA time zone")
+
+	updateRequest.TimeZone = nil
+	payload, err = json.Marshal(updateRequest)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "timeZone")
+
+	user := &User{TimeZone: "America/New_York"}
+	location, err := user.Location()
+	assert.Nil(t, err)
+	assert.Equal(t, "America/New_York", location.String())
+}
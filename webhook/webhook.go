@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/pkg/errors"
+)
+
+// Payload is the body of an alert action posted by the OpsGenie webhook integration.
+type Payload struct {
+	Action          string `json:"action"`
+	Alert           Alert  `json:"alert"`
+	Source          Source `json:"source"`
+	IntegrationId   string `json:"integrationId"`
+	IntegrationName string `json:"integrationName"`
+	IntegrationType string `json:"integrationType"`
+}
+
+type Source struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Alert struct {
+	AlertId     string            `json:"alertId"`
+	TinyId      string            `json:"tinyId"`
+	Alias       string            `json:"alias"`
+	Message     string            `json:"message"`
+	Description string            `json:"description"`
+	Entity      string            `json:"entity"`
+	Source      string            `json:"source"`
+	Priority    alert.Priority    `json:"priority"`
+	Tags        []string          `json:"tags"`
+	Actions     []string          `json:"actions"`
+	Details     map[string]string `json:"details"`
+	Username    string            `json:"username"`
+	UserId      string            `json:"userId"`
+	Teams       []string          `json:"teams"`
+	Recipients  []string          `json:"recipients"`
+	Responders  []Responder       `json:"responders"`
+	CreatedAt   int64             `json:"createdAt"`
+	UpdatedAt   int64             `json:"updatedAt"`
+}
+
+// Responder is a responder as it appears in webhook payloads. Use Alert.NormalizedResponders to get them in the
+// form alert requests accept.
+type Responder struct {
+	Id       string `json:"id"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+func Parse(body []byte) (*Payload, error) {
+	payload := &Payload{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, errors.Wrap(err, "Could not parse webhook payload")
+	}
+	if payload.Action == "" {
+		return nil, errors.New("Action cannot be empty.")
+	}
+	return payload, nil
+}
+
+func ParseRequest(r *http.Request) (*Payload, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(body)
+}
+
+// NormalizedResponders returns the responders of the alert as alert.Responder values, so they can be passed to
+// alert requests as they are. Teams that are only listed by name in older payloads are included as team responders.
+func (a *Alert) NormalizedResponders() []alert.Responder {
+	responders := make([]alert.Responder, 0, len(a.Responders)+len(a.Teams))
+	teams := make(map[string]bool)
+	for _, r := range a.Responders {
+		responder := alert.Responder{Type: alert.ResponderType(strings.ToLower(r.Type)), Id: r.Id, Name: r.Name, Username: r.Username}
+		if responder.Type == alert.UserResponder && responder.Username == "" {
+			responder.Username = r.Name
+			responder.Name = ""
+		}
+		if responder.Type == alert.TeamResponder {
+			teams[strings.ToLower(r.Name)] = true
+		}
+		responders = append(responders, responder)
+	}
+	for _, name := range a.Teams {
+		if name != "" && !teams[strings.ToLower(name)] {
+			teams[strings.ToLower(name)] = true
+			responders = append(responders, alert.Responder{Type: alert.TeamResponder, Name: name})
+		}
+	}
+	return responders
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/joeyparsons/opsgenie-go-sdk-v2/alert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	payload, err := Parse([]byte(`{
+		"action": "Create",
+		"alert": {
+			"alertId": "a1",
+			"message": "Database is down",
+			"priority": "P1",
+			"teams": ["ops", "dba"],
+			"responders": [
+				{"id": "t1", "type": "team", "name": "ops"},
+				{"id": "u1", "type": "User", "name": "john@example.com"},
+				{"id": "e1", "type": "escalation", "name": "ops_escalation"}
+			]
+		},
+		"source": {"name": "web", "type": "web"},
+		"integrationName": "Webhook"
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "Create", payload.Action)
+	assert.Equal(t, alert.P1, payload.Alert.Priority)
+	assert.Equal(t, []alert.Responder{
+		{Type: alert.TeamResponder, Id: "t1", Name: "ops"},
+		{Type: alert.UserResponder, Id: "u1", Username: "john@example.com"},
+		{Type: alert.EscalationResponder, Id: "e1", Name: "ops_escalation"},
+		{Type: alert.TeamResponder, Name: "dba"},
+	}, payload.Alert.NormalizedResponders())
+
+	_, err = Parse([]byte(`{"alert": {}}`))
+	assert.EqualError(t, err, "Action cannot be empty.")
+
+	_, err = Parse([]byte(`{`))
+	assert.NotNil(t, err)
+}